@@ -0,0 +1,397 @@
+// Package adf builds and converts Atlassian Document Format (ADF) documents,
+// the rich text format of issue descriptions, comments and worklogs.
+package adf
+
+import (
+	"encoding/json"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// Document is an ADF document under construction, created with Doc.
+// It can be used directly as a comment or description body, since it marshals to ADF.
+type Document struct {
+	content []responsetypes.NodeContent
+}
+
+// Doc creates a document with the given blocks
+func Doc(blocks ...Block) *Document {
+	return new(Document).Append(blocks...)
+}
+
+// Append adds blocks to the end of the document
+func (d *Document) Append(blocks ...Block) *Document {
+	d.content = appendBlocks(d.content, blocks)
+	return d
+}
+
+// Paragraph adds a paragraph
+func (d *Document) Paragraph(content ...Inline) *Document {
+	return d.Append(Paragraph(content...))
+}
+
+// Text adds a paragraph of plain text
+func (d *Document) Text(text string) *Document {
+	return d.Append(Paragraph(Text(text)))
+}
+
+// Heading adds a heading of plain text
+func (d *Document) Heading(level int, text string) *Document {
+	return d.Append(Heading(level, text))
+}
+
+// BulletList adds a bulleted list
+func (d *Document) BulletList(items ...ListItem) *Document {
+	return d.Append(BulletList(items...))
+}
+
+// OrderedList adds a numbered list
+func (d *Document) OrderedList(items ...ListItem) *Document {
+	return d.Append(OrderedList(items...))
+}
+
+// CodeBlock adds a block of code, language is optional
+func (d *Document) CodeBlock(language, code string) *Document {
+	return d.Append(CodeBlock(language, code))
+}
+
+// Blockquote adds a quote
+func (d *Document) Blockquote(blocks ...QuoteBlock) *Document {
+	return d.Append(Blockquote(blocks...))
+}
+
+// Panel adds a panel, panelType is one of the PANEL_TYPE_* constants
+func (d *Document) Panel(panelType string, blocks ...PanelBlock) *Document {
+	return d.Append(Panel(panelType, blocks...))
+}
+
+// Rule adds a horizontal rule
+func (d *Document) Rule() *Document {
+	return d.Append(Rule())
+}
+
+// Table adds a table
+func (d *Document) Table(rows ...TableRow) *Document {
+	return d.Append(Table(rows...))
+}
+
+// Expand adds a collapsible section
+func (d *Document) Expand(title string, blocks ...ExpandBlock) *Document {
+	return d.Append(Expand(title, blocks...))
+}
+
+// Build returns the document as ADF
+func (d *Document) Build() responsetypes.AtlassianDocumentFormat {
+	content := make([]responsetypes.DocumentNode, 0, len(d.content))
+	for _, node := range d.content {
+		content = append(content, responsetypes.DocumentNode{
+			Type:    node.Type,
+			Content: node.Content,
+			Marks:   node.Marks,
+			Attrs:   node.Attrs,
+			Text:    node.Text,
+		})
+	}
+
+	return responsetypes.AtlassianDocumentFormat{
+		Type:    responsetypes.NodeTypeDoc,
+		Version: ADF_VERSION,
+		Content: content,
+	}
+}
+
+// MarshalJSON encodes the document as ADF
+func (d *Document) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Build())
+}
+
+// Block is a top-level block of content: a paragraph, heading, list, code block, quote, panel, rule, table or expand.
+// The narrower block interfaces below are the blocks that may be nested in other blocks.
+type Block interface {
+	node() responsetypes.NodeContent
+}
+
+// ExpandBlock is a block allowed in an expand: any block but another expand
+type ExpandBlock interface {
+	Block
+	expandBlock()
+}
+
+// CellBlock is a block allowed in a table cell: any block but a table or an expand
+type CellBlock interface {
+	ExpandBlock
+	cellBlock()
+}
+
+// PanelBlock is a block allowed in a panel: a paragraph, heading, list, code block or rule
+type PanelBlock interface {
+	CellBlock
+	panelBlock()
+}
+
+// QuoteBlock is a block allowed in a quote or a list item: a paragraph, list or code block
+type QuoteBlock interface {
+	PanelBlock
+	quoteBlock()
+}
+
+// blockNode is a block built by one of the block constructors.
+// A block with no type, e.g. a list without items, is left out of the document.
+type blockNode responsetypes.NodeContent
+
+func (n blockNode) node() responsetypes.NodeContent {
+	return responsetypes.NodeContent(n)
+}
+
+// expandContent, cellContent, panelContent and quoteContent mark the blocks that may be nested,
+// each allowed wherever the ones before it are
+type (
+	expandContent struct{}
+	cellContent   struct{ expandContent }
+	panelContent  struct{ cellContent }
+	quoteContent  struct{ panelContent }
+)
+
+func (expandContent) expandBlock() {}
+func (cellContent) cellBlock()     {}
+func (panelContent) panelBlock()   {}
+func (quoteContent) quoteBlock()   {}
+
+// expandNode, cellNode, panelNode and quoteNode are the blocks allowed in expands, table cells, panels and quotes
+type (
+	expandNode struct {
+		blockNode
+		expandContent
+	}
+	cellNode struct {
+		blockNode
+		cellContent
+	}
+	panelNode struct {
+		blockNode
+		panelContent
+	}
+	quoteNode struct {
+		blockNode
+		quoteContent
+	}
+)
+
+// appendBlocks appends the nodes of blocks to nodes, skipping empty blocks
+func appendBlocks[B Block](nodes []responsetypes.NodeContent, blocks []B) []responsetypes.NodeContent {
+	for _, block := range blocks {
+		if any(block) == nil {
+			continue
+		}
+		if node := block.node(); node.Type != "" {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// Paragraph creates a paragraph
+func Paragraph(content ...Inline) QuoteBlock {
+	return quoteNode{blockNode: blockNode{Type: responsetypes.NodeTypeParagraph, Content: inlineContent(content)}}
+}
+
+// Heading creates a heading of plain text, level is clamped between 1 and 6
+func Heading(level int, text string) PanelBlock {
+	return RichHeading(level, Text(text))
+}
+
+// RichHeading creates a heading with formatted content, level is clamped between 1 and 6
+func RichHeading(level int, content ...Inline) PanelBlock {
+	return panelNode{blockNode: blockNode{
+		Type:    responsetypes.NodeTypeHeading,
+		Attrs:   &responsetypes.NodeAttrs{Level: min(max(level, 1), 6)},
+		Content: inlineContent(content),
+	}}
+}
+
+// CodeBlock creates a block of code, language is optional
+func CodeBlock(language, code string) QuoteBlock {
+	node := blockNode{Type: responsetypes.NodeTypeCodeBlock}
+	if language != "" {
+		node.Attrs = &responsetypes.NodeAttrs{Language: language}
+	}
+	if code != "" {
+		node.Content = []responsetypes.NodeContent{{Type: responsetypes.NodeTypeText, Text: code}}
+	}
+	return quoteNode{blockNode: node}
+}
+
+// Blockquote creates a quote of paragraphs, lists and code blocks
+func Blockquote(blocks ...QuoteBlock) CellBlock {
+	return cellNode{blockNode: containerBlock(responsetypes.NodeTypeBlockquote, nil, blocks)}
+}
+
+// Panel creates a panel, panelType is one of the PANEL_TYPE_* constants and defaults to info
+func Panel(panelType string, blocks ...PanelBlock) CellBlock {
+	if panelType == "" {
+		panelType = PANEL_TYPE_INFO
+	}
+	return cellNode{blockNode: containerBlock(responsetypes.NodeTypePanel, &responsetypes.NodeAttrs{PanelType: panelType}, blocks)}
+}
+
+// Expand creates a collapsible section, it is only allowed at the top level of a document
+func Expand(title string, blocks ...ExpandBlock) Block {
+	return containerBlock(responsetypes.NodeTypeExpand, &responsetypes.NodeAttrs{Title: title}, blocks)
+}
+
+// Rule creates a horizontal rule
+func Rule() PanelBlock {
+	return panelNode{blockNode: blockNode{Type: responsetypes.NodeTypeRule}}
+}
+
+// containerBlock creates a block holding other blocks, with an empty paragraph when there are none
+func containerBlock[B Block](nodeType string, attrs *responsetypes.NodeAttrs, blocks []B) blockNode {
+	content := appendBlocks(nil, blocks)
+	if len(content) == 0 {
+		content = []responsetypes.NodeContent{{Type: responsetypes.NodeTypeParagraph}}
+	}
+	return blockNode{Type: nodeType, Attrs: attrs, Content: content}
+}
+
+// ListItem is an item of a bulleted or numbered list, created with Item or Items
+type ListItem struct {
+	content []responsetypes.NodeContent
+}
+
+// Item creates a list item holding a paragraph
+func Item(content ...Inline) ListItem {
+	return ListItem{content: []responsetypes.NodeContent{{Type: responsetypes.NodeTypeParagraph, Content: inlineContent(content)}}}
+}
+
+// Items creates a list item of plain text per text
+func Items(texts ...string) []ListItem {
+	items := make([]ListItem, 0, len(texts))
+	for _, text := range texts {
+		items = append(items, Item(Text(text)))
+	}
+	return items
+}
+
+// With returns a copy of the item with blocks added after its paragraph, e.g. a nested list or a code block
+func (i ListItem) With(blocks ...QuoteBlock) ListItem {
+	return ListItem{content: appendBlocks(append([]responsetypes.NodeContent(nil), i.content...), blocks)}
+}
+
+// BulletList creates a bulleted list, a list without items is left out
+func BulletList(items ...ListItem) QuoteBlock {
+	return listBlock(responsetypes.NodeTypeBulletList, nil, items)
+}
+
+// OrderedList creates a numbered list, a list without items is left out
+func OrderedList(items ...ListItem) QuoteBlock {
+	return listBlock(responsetypes.NodeTypeOrderedList, nil, items)
+}
+
+// OrderedListFrom creates a numbered list starting at start
+func OrderedListFrom(start int, items ...ListItem) QuoteBlock {
+	return listBlock(responsetypes.NodeTypeOrderedList, &responsetypes.NodeAttrs{Order: max(start, 1)}, items)
+}
+
+// listBlock creates a list of items
+func listBlock(nodeType string, attrs *responsetypes.NodeAttrs, items []ListItem) QuoteBlock {
+	if len(items) == 0 {
+		return quoteNode{}
+	}
+
+	node := blockNode{Type: nodeType, Attrs: attrs}
+	for _, item := range items {
+		node.Content = append(node.Content, responsetypes.NodeContent{Type: responsetypes.NodeTypeListItem, Content: item.content})
+	}
+	return quoteNode{blockNode: node}
+}
+
+// TableRow is a row of a table, created with Row, HeaderRow or TextRow
+type TableRow struct {
+	cells []responsetypes.NodeContent
+}
+
+// TableCell is a cell of a table, created with Cell or HeaderCell
+type TableCell struct {
+	node responsetypes.NodeContent
+}
+
+// Cell creates a table cell holding a paragraph
+func Cell(content ...Inline) TableCell {
+	return newTableCell(responsetypes.NodeTypeTableCell, content)
+}
+
+// HeaderCell creates a table header cell holding a paragraph
+func HeaderCell(content ...Inline) TableCell {
+	return newTableCell(responsetypes.NodeTypeTableHeader, content)
+}
+
+// newTableCell creates a cell of the given type holding a paragraph
+func newTableCell(nodeType string, content []Inline) TableCell {
+	return TableCell{node: responsetypes.NodeContent{
+		Type:    nodeType,
+		Content: []responsetypes.NodeContent{{Type: responsetypes.NodeTypeParagraph, Content: inlineContent(content)}},
+	}}
+}
+
+// With returns a copy of the cell with blocks added after its paragraph
+func (c TableCell) With(blocks ...CellBlock) TableCell {
+	node := c.node
+	node.Content = appendBlocks(append([]responsetypes.NodeContent(nil), c.node.Content...), blocks)
+	return TableCell{node: node}
+}
+
+// Background returns a copy of the cell with a background color, as a hex code such as "#deebff"
+func (c TableCell) Background(color string) TableCell {
+	node := c.node
+	attrs := responsetypes.NodeAttrs{}
+	if node.Attrs != nil {
+		attrs = *node.Attrs
+	}
+	attrs.Background = color
+	node.Attrs = &attrs
+	return TableCell{node: node}
+}
+
+// Row creates a table row
+func Row(cells ...TableCell) TableRow {
+	row := TableRow{}
+	for _, cell := range cells {
+		row.cells = append(row.cells, cell.node)
+	}
+	return row
+}
+
+// HeaderRow creates a row of header cells of plain text
+func HeaderRow(texts ...string) TableRow {
+	cells := make([]TableCell, 0, len(texts))
+	for _, text := range texts {
+		cells = append(cells, HeaderCell(Text(text)))
+	}
+	return Row(cells...)
+}
+
+// TextRow creates a row of cells of plain text
+func TextRow(texts ...string) TableRow {
+	cells := make([]TableCell, 0, len(texts))
+	for _, text := range texts {
+		cells = append(cells, Cell(Text(text)))
+	}
+	return Row(cells...)
+}
+
+// Table creates a table, rows without cells are left out and so is a table without rows
+func Table(rows ...TableRow) ExpandBlock {
+	node := blockNode{
+		Type:  responsetypes.NodeTypeTable,
+		Attrs: &responsetypes.NodeAttrs{Layout: TABLE_LAYOUT_DEFAULT},
+	}
+	for _, row := range rows {
+		if len(row.cells) > 0 {
+			node.Content = append(node.Content, responsetypes.NodeContent{Type: responsetypes.NodeTypeTableRow, Content: row.cells})
+		}
+	}
+	if len(node.Content) == 0 {
+		return expandNode{}
+	}
+	return expandNode{blockNode: node}
+}
@@ -0,0 +1,174 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDocument(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  *Document
+		want string
+	}{
+		{
+			name: "empty",
+			doc:  Doc(),
+			want: `{"type":"doc","version":1,"content":[]}`,
+		},
+		{
+			name: "heading and paragraph",
+			doc:  Doc().Heading(2, "Deploy").Paragraph(Text("ok").Bold(), Text(" see "), Link("logs", "https://ci.example.com")),
+			want: `{"type":"doc","version":1,"content":[` +
+				`{"type":"heading","content":[{"type":"text","text":"Deploy"}],"attrs":{"level":2}},` +
+				`{"type":"paragraph","content":[{"type":"text","text":"ok","marks":[{"type":"strong"}]},{"type":"text","text":" see "},` +
+				`{"type":"text","text":"logs","marks":[{"type":"link","attrs":{"href":"https://ci.example.com"}}]}]}]}`,
+		},
+		{
+			name: "heading level is clamped",
+			doc:  Doc().Heading(9, "Deep"),
+			want: `{"type":"doc","version":1,"content":[{"type":"heading","content":[{"type":"text","text":"Deep"}],"attrs":{"level":6}}]}`,
+		},
+		{
+			name: "nested lists",
+			doc: Doc().BulletList(
+				Item(Text("one")).With(OrderedList(Items("a", "b")...)),
+				Item(Text("two")),
+			),
+			want: `{"type":"doc","version":1,"content":[{"type":"bulletList","content":[` +
+				`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"one"}]},` +
+				`{"type":"orderedList","content":[` +
+				`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"a"}]}]},` +
+				`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"b"}]}]}]}]},` +
+				`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}]}]}`,
+		},
+		{
+			name: "empty list is left out",
+			doc:  Doc().BulletList().Append(OrderedListFrom(3, Items("c")...)),
+			want: `{"type":"doc","version":1,"content":[{"type":"orderedList","content":[` +
+				`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"c"}]}]}],"attrs":{"order":3}}]}`,
+		},
+		{
+			name: "code block",
+			doc:  Doc().CodeBlock("go", "fmt.Println(\"hi\")\n").CodeBlock("", ""),
+			want: `{"type":"doc","version":1,"content":[` +
+				`{"type":"codeBlock","content":[{"type":"text","text":"fmt.Println(\"hi\")\n"}],"attrs":{"language":"go"}},` +
+				`{"type":"codeBlock"}]}`,
+		},
+		{
+			name: "panel, quote, rule and expand",
+			doc: Doc().
+				Panel(PANEL_TYPE_WARNING, Paragraph(Text("careful"))).
+				Blockquote().
+				Rule().
+				Expand("Details", Paragraph(Mention("abc", "Mia"), Emoji(":+1:"))),
+			want: `{"type":"doc","version":1,"content":[` +
+				`{"type":"panel","content":[{"type":"paragraph","content":[{"type":"text","text":"careful"}]}],"attrs":{"panelType":"warning"}},` +
+				`{"type":"blockquote","content":[{"type":"paragraph"}]},` +
+				`{"type":"rule"},` +
+				`{"type":"expand","content":[{"type":"paragraph","content":[{"type":"mention","attrs":{"id":"abc","text":"@Mia"}},` +
+				`{"type":"emoji","attrs":{"shortName":":+1:"}}]}],"attrs":{"title":"Details"}}]}`,
+		},
+		{
+			name: "table",
+			doc: Doc().Table(
+				HeaderRow("Service", "State"),
+				Row(Cell(Text("api")), Cell(Status("UP", STATUS_COLOR_GREEN)).Background("#e3fcef")),
+				Row(),
+			),
+			want: `{"type":"doc","version":1,"content":[{"type":"table","content":[` +
+				`{"type":"tableRow","content":[` +
+				`{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Service"}]}]},` +
+				`{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"State"}]}]}]},` +
+				`{"type":"tableRow","content":[` +
+				`{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"api"}]}]},` +
+				`{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"status","attrs":{"text":"UP","color":"green"}}]}],"attrs":{"background":"#e3fcef"}}]}],` +
+				`"attrs":{"layout":"default"}}]}`,
+		},
+		{
+			name: "empty table is left out",
+			doc:  Doc().Table(Row()).Text("after"),
+			want: `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"after"}]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.doc)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("document =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListItem_With(t *testing.T) {
+	base := Item(Text("one"))
+	_ = base.With(CodeBlock("", "x"))
+
+	if len(base.content) != 1 {
+		t.Errorf("base item content = %d nodes, want 1", len(base.content))
+	}
+}
+
+func TestDocument_Validate(t *testing.T) {
+	inline := []Inline{
+		Text("text").Bold(), Link("link", "https://example.com"), Link("no link", ""), Mention("abc", "Mia"), Mention("", "nobody"),
+		Status("UP", ""), Status("", STATUS_COLOR_RED), Emoji("tada"), Emoji(""), Date(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+		InlineCard(""), HardBreak(),
+	}
+
+	// The blocks allowed at each level, each holding every block allowed in it
+	quote := []QuoteBlock{
+		Paragraph(inline...), Paragraph(), CodeBlock("go", "x := 1"), CodeBlock("", ""), BulletList(),
+		BulletList(Item(inline...), Item()), OrderedListFrom(3, Items("a", "b")...),
+	}
+	for _, block := range quote {
+		quote = append(quote, OrderedList(Item(Text("item")).With(block)))
+	}
+	panel := []PanelBlock{Heading(2, "heading"), RichHeading(9, inline...), Rule()}
+	for _, block := range quote {
+		panel = append(panel, block)
+	}
+	cell := []CellBlock{Blockquote(quote...), Blockquote(), Panel("", panel...), Panel(PANEL_TYPE_ERROR)}
+	for _, block := range panel {
+		cell = append(cell, block)
+	}
+	expand := []ExpandBlock{Table(HeaderRow("a", "b"), Row(Cell(inline...).With(cell...), HeaderCell().With(cell...)), Row()), Table()}
+	for _, block := range cell {
+		expand = append(expand, block)
+	}
+	blocks := []Block{Expand("title", expand...), Expand("")}
+	for _, block := range expand {
+		blocks = append(blocks, block)
+	}
+
+	// Every block in every container it is allowed in
+	var docs []*Document
+	for _, block := range blocks {
+		docs = append(docs, Doc(block))
+	}
+	for _, block := range expand {
+		docs = append(docs, Doc(Expand("title", block)))
+	}
+	for _, block := range cell {
+		docs = append(docs, Doc(Table(Row(Cell(Text("cell")).With(block), HeaderCell().With(block)))))
+	}
+	for _, block := range panel {
+		docs = append(docs, Doc(Panel(PANEL_TYPE_NOTE, block)))
+	}
+	for _, block := range quote {
+		docs = append(docs, Doc(Blockquote(block)), Doc(BulletList(Item().With(block))))
+	}
+
+	for i, doc := range docs {
+		if err := Validate(doc.Build()); err != nil {
+			got, _ := json.Marshal(doc)
+			t.Errorf("document %d: Validate() error = %v\n%s", i, err, got)
+		}
+	}
+}
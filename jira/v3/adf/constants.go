@@ -0,0 +1,30 @@
+package adf
+
+const (
+	// The version of the Atlassian Document Format written by Doc
+	ADF_VERSION = 1
+
+	// Panel types
+	PANEL_TYPE_INFO    = "info"
+	PANEL_TYPE_NOTE    = "note"
+	PANEL_TYPE_WARNING = "warning"
+	PANEL_TYPE_SUCCESS = "success"
+	PANEL_TYPE_ERROR   = "error"
+
+	// Status lozenge colors
+	STATUS_COLOR_NEUTRAL = "neutral"
+	STATUS_COLOR_PURPLE  = "purple"
+	STATUS_COLOR_BLUE    = "blue"
+	STATUS_COLOR_RED     = "red"
+	STATUS_COLOR_YELLOW  = "yellow"
+	STATUS_COLOR_GREEN   = "green"
+
+	// Table layouts
+	TABLE_LAYOUT_DEFAULT    = "default"
+	TABLE_LAYOUT_WIDE       = "wide"
+	TABLE_LAYOUT_FULL_WIDTH = "full-width"
+
+	// Values of the subsup mark
+	SUBSUP_SUB = "sub"
+	SUBSUP_SUP = "sup"
+)
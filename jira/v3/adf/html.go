@@ -0,0 +1,255 @@
+package adf
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// htmlMarkOrder is the nesting order of marks written to HTML, outermost first
+var htmlMarkOrder = []string{
+	responsetypes.MarkTypeLink,
+	responsetypes.MarkTypeStrong,
+	responsetypes.MarkTypeEm,
+	responsetypes.MarkTypeUnderline,
+	responsetypes.MarkTypeStrike,
+	responsetypes.MarkTypeSubSup,
+	responsetypes.MarkTypeTextColor,
+	responsetypes.MarkTypeBackgroundColor,
+	responsetypes.MarkTypeCode,
+}
+
+// htmlSafeSchemes are the URL schemes written to links and images, URLs without a scheme are kept as relative URLs
+var htmlSafeSchemes = []string{"http", "https", "mailto"}
+
+// htmlColor matches the hex colors written to style attributes
+var htmlColor = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// htmlLanguage matches the code block languages written to class attributes
+var htmlLanguage = regexp.MustCompile(`^[A-Za-z0-9_+#.-]+$`)
+
+// htmlNodes renders nodes one after another
+func (r *Renderer) htmlNodes(nodes []responsetypes.NodeContent) string {
+	var b strings.Builder
+	for _, node := range nodes {
+		b.WriteString(r.htmlNode(node))
+	}
+	return b.String()
+}
+
+// htmlNode renders a node and its content
+func (r *Renderer) htmlNode(node responsetypes.NodeContent) string {
+	content := func() string { return r.htmlNodes(node.Content) }
+	if out, ok := r.handle(node, content); ok {
+		return out
+	}
+
+	a := attrs(node)
+	switch node.Type {
+	case responsetypes.NodeTypeText:
+		return htmlText(node)
+	case responsetypes.NodeTypeParagraph:
+		return "<p>" + content() + "</p>"
+	case responsetypes.NodeTypeHeading:
+		tag := "h" + strconv.Itoa(min(max(a.Level, 1), 6))
+		return "<" + tag + ">" + content() + "</" + tag + ">"
+	case responsetypes.NodeTypeBulletList:
+		return "<ul>" + content() + "</ul>"
+	case responsetypes.NodeTypeOrderedList:
+		if a.Order > 1 {
+			return `<ol start="` + strconv.Itoa(a.Order) + `">` + content() + "</ol>"
+		}
+		return "<ol>" + content() + "</ol>"
+	case responsetypes.NodeTypeListItem, responsetypes.NodeTypeDecisionItem:
+		return "<li>" + content() + "</li>"
+	case responsetypes.NodeTypeTaskList:
+		return `<ul class="adf-task-list">` + content() + "</ul>"
+	case responsetypes.NodeTypeTaskItem:
+		if a.State == "DONE" {
+			return `<li class="adf-task-done">&#9745; ` + content() + "</li>"
+		}
+		return "<li>&#9744; " + content() + "</li>"
+	case responsetypes.NodeTypeDecisionList:
+		return `<ul class="adf-decision-list">` + content() + "</ul>"
+	case responsetypes.NodeTypeCodeBlock:
+		code := html.EscapeString(plainText(node.Content))
+		if htmlLanguage.MatchString(a.Language) {
+			return `<pre><code class="language-` + a.Language + `">` + code + "</code></pre>"
+		}
+		return "<pre><code>" + code + "</code></pre>"
+	case responsetypes.NodeTypeBlockquote:
+		return "<blockquote>" + content() + "</blockquote>"
+	case responsetypes.NodeTypePanel:
+		panelType := a.PanelType
+		if _, ok := markdownAlerts[panelType]; !ok {
+			panelType = PANEL_TYPE_INFO
+		}
+		return `<div class="adf-panel adf-panel-` + panelType + `">` + content() + "</div>"
+	case responsetypes.NodeTypeRule:
+		return "<hr>"
+	case responsetypes.NodeTypeTable:
+		return "<table><tbody>" + content() + "</tbody></table>"
+	case responsetypes.NodeTypeTableRow:
+		return "<tr>" + content() + "</tr>"
+	case responsetypes.NodeTypeTableCell, responsetypes.NodeTypeTableHeader:
+		tag := "td"
+		if node.Type == responsetypes.NodeTypeTableHeader {
+			tag = "th"
+		}
+		return "<" + tag + htmlCellAttrs(a) + ">" + content() + "</" + tag + ">"
+	case responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeMediaGroup:
+		return `<div class="adf-media">` + content() + "</div>"
+	case responsetypes.NodeTypeMedia, responsetypes.NodeTypeMediaInline:
+		return htmlMedia(a)
+	case responsetypes.NodeTypeExpand, responsetypes.NodeTypeNestedExpand:
+		return "<details><summary>" + html.EscapeString(a.Title) + "</summary>" + content() + "</details>"
+	case responsetypes.NodeTypeBlockCard, responsetypes.NodeTypeEmbedCard:
+		return "<p>" + htmlLink(a.URL, html.EscapeString(a.URL)) + "</p>"
+	case responsetypes.NodeTypeInlineCard:
+		return htmlLink(a.URL, html.EscapeString(a.URL))
+	case responsetypes.NodeTypeHardBreak:
+		return "<br>"
+	case responsetypes.NodeTypeMention:
+		name := a.Text
+		if name == "" {
+			name = a.ID
+		}
+		if !strings.HasPrefix(name, "@") {
+			name = "@" + name
+		}
+		return `<span class="adf-mention" data-account-id="` + html.EscapeString(a.ID) + `">` + html.EscapeString(name) + "</span>"
+	case responsetypes.NodeTypeEmoji:
+		if a.Text != "" {
+			return html.EscapeString(a.Text)
+		}
+		return html.EscapeString(a.ShortName)
+	case responsetypes.NodeTypeStatus:
+		color := a.Color
+		if !slices.Contains(statusColors, color) {
+			color = STATUS_COLOR_NEUTRAL
+		}
+		return `<span class="adf-status adf-status-` + color + `">` + html.EscapeString(a.Text) + "</span>"
+	case responsetypes.NodeTypeDate:
+		date := html.EscapeString(formatTimestamp(a.Timestamp))
+		return `<time datetime="` + date + `">` + date + "</time>"
+	case responsetypes.NodeTypeExtension, responsetypes.NodeTypeInlineExtension:
+		// Extensions are rendered by their app, they have no content to fall back to
+		return ""
+	}
+
+	// Unknown nodes, e.g. bodied extensions, keep their content
+	return content()
+}
+
+// statusColors are the colors of status lozenges
+var statusColors = []string{
+	STATUS_COLOR_NEUTRAL, STATUS_COLOR_PURPLE, STATUS_COLOR_BLUE, STATUS_COLOR_RED, STATUS_COLOR_YELLOW, STATUS_COLOR_GREEN,
+}
+
+// htmlText renders a text node inside the elements of its marks
+func htmlText(node responsetypes.NodeContent) string {
+	text := html.EscapeString(node.Text)
+	for i := len(htmlMarkOrder) - 1; i >= 0; i-- {
+		for _, mark := range node.Marks {
+			if mark.Type == htmlMarkOrder[i] {
+				text = htmlMark(mark, text)
+			}
+		}
+	}
+	return text
+}
+
+// htmlMark wraps rendered text in the element of a mark, dropping marks with unsafe attributes
+func htmlMark(mark responsetypes.Mark, text string) string {
+	var a responsetypes.MarkAttrs
+	if mark.Attrs != nil {
+		a = *mark.Attrs
+	}
+
+	switch mark.Type {
+	case responsetypes.MarkTypeLink:
+		return htmlLink(a.Href, text)
+	case responsetypes.MarkTypeStrong:
+		return "<strong>" + text + "</strong>"
+	case responsetypes.MarkTypeEm:
+		return "<em>" + text + "</em>"
+	case responsetypes.MarkTypeUnderline:
+		return "<u>" + text + "</u>"
+	case responsetypes.MarkTypeStrike:
+		return "<s>" + text + "</s>"
+	case responsetypes.MarkTypeCode:
+		return "<code>" + text + "</code>"
+	case responsetypes.MarkTypeSubSup:
+		if a.Type == SUBSUP_SUB {
+			return "<sub>" + text + "</sub>"
+		}
+		return "<sup>" + text + "</sup>"
+	case responsetypes.MarkTypeTextColor:
+		if htmlColor.MatchString(a.Color) {
+			return `<span style="color: ` + a.Color + `">` + text + "</span>"
+		}
+	case responsetypes.MarkTypeBackgroundColor:
+		if htmlColor.MatchString(a.Color) {
+			return `<span style="background-color: ` + a.Color + `">` + text + "</span>"
+		}
+	}
+	return text
+}
+
+// htmlLink wraps rendered text in a link, or returns the text alone when the URL is not safe
+func htmlLink(href, text string) string {
+	href, ok := safeURL(href)
+	if !ok {
+		return text
+	}
+	return `<a href="` + html.EscapeString(href) + `" rel="noopener noreferrer">` + text + "</a>"
+}
+
+// htmlMedia renders external media as an image and attachments as a placeholder
+func htmlMedia(a responsetypes.NodeAttrs) string {
+	if src, ok := safeURL(a.URL); ok && !strings.HasPrefix(src, "mailto:") {
+		return `<img src="` + html.EscapeString(src) + `" alt="` + html.EscapeString(a.Alt) + `">`
+	}
+
+	name := a.Alt
+	if name == "" {
+		name = a.ID
+	}
+	return `<span class="adf-media-placeholder">` + html.EscapeString("[media: "+name+"]") + "</span>"
+}
+
+// htmlCellAttrs renders the span and background attributes of a table cell
+func htmlCellAttrs(a responsetypes.NodeAttrs) string {
+	var b strings.Builder
+	if a.Colspan > 1 {
+		b.WriteString(` colspan="` + strconv.Itoa(a.Colspan) + `"`)
+	}
+	if a.Rowspan > 1 {
+		b.WriteString(` rowspan="` + strconv.Itoa(a.Rowspan) + `"`)
+	}
+	if htmlColor.MatchString(a.Background) {
+		b.WriteString(` style="background-color: ` + a.Background + `"`)
+	}
+	return b.String()
+}
+
+// safeURL returns a URL when it is relative or uses one of the safe schemes, rejecting e.g. javascript: URLs
+func safeURL(rawURL string) (string, bool) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" || strings.ContainsFunc(rawURL, func(r rune) bool { return r < ' ' || r == 0x7f }) {
+		return "", false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	if u.Scheme == "" {
+		return rawURL, true
+	}
+	return rawURL, slices.Contains(htmlSafeSchemes, strings.ToLower(u.Scheme))
+}
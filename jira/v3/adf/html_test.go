@@ -0,0 +1,169 @@
+package adf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  *Document
+		want string
+	}{
+		{
+			name: "empty",
+			doc:  Doc(),
+			want: "",
+		},
+		{
+			name: "headings and marks",
+			doc: Doc().
+				Heading(2, "Release").
+				Paragraph(Text("Build "), Text("passed").Bold().Italic(), Text(" on "), Text("main").Code(), Text(", see "), Link("logs", "https://ci.example.com/1?a=1&b=2")),
+			want: "<h2>Release</h2>" +
+				`<p>Build <strong><em>passed</em></strong> on <code>main</code>, see <a href="https://ci.example.com/1?a=1&amp;b=2" rel="noopener noreferrer">logs</a></p>`,
+		},
+		{
+			name: "colors and subscript",
+			doc:  Doc().Paragraph(Text("H"), Text("2").Sub(), Text("O").Color("#ff5630").Underline()),
+			want: `<p>H<sub>2</sub><u><span style="color: #ff5630">O</span></u></p>`,
+		},
+		{
+			name: "hard breaks",
+			doc:  Doc().Paragraph(Text("line one\nline two")),
+			want: "<p>line one<br>line two</p>",
+		},
+		{
+			name: "inline nodes",
+			doc: Doc().Paragraph(
+				Mention("abc", "Mia Krystof"), Text(" "), Emoji("tada"), Text(" "), Status("DONE", STATUS_COLOR_GREEN), Text(" "),
+				Date(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)), Text(" "), InlineCard("https://example.com/PROJ-1"),
+			),
+			want: `<p><span class="adf-mention" data-account-id="abc">@Mia Krystof</span> :tada: ` +
+				`<span class="adf-status adf-status-green">DONE</span> <time datetime="2024-03-01">2024-03-01</time> ` +
+				`<a href="https://example.com/PROJ-1" rel="noopener noreferrer">https://example.com/PROJ-1</a></p>`,
+		},
+		{
+			name: "lists",
+			doc: Doc().
+				BulletList(Item(Text("one")).With(OrderedList(Items("a")...)), Item(Text("two"))).
+				Append(OrderedListFrom(3, Item(Text("three")))),
+			want: "<ul><li><p>one</p><ol><li><p>a</p></li></ol></li><li><p>two</p></li></ul>" +
+				`<ol start="3"><li><p>three</p></li></ol>`,
+		},
+		{
+			name: "code block",
+			doc:  Doc().CodeBlock("go", "if a < b {}"),
+			want: `<pre><code class="language-go">if a &lt; b {}</code></pre>`,
+		},
+		{
+			name: "quote, panel, expand and rule",
+			doc: Doc().
+				Blockquote(Paragraph(Text("quoted"))).
+				Panel(PANEL_TYPE_WARNING, Paragraph(Text("careful"))).
+				Expand("Details", Paragraph(Text("more"))).
+				Rule(),
+			want: "<blockquote><p>quoted</p></blockquote>" +
+				`<div class="adf-panel adf-panel-warning"><p>careful</p></div>` +
+				"<details><summary>Details</summary><p>more</p></details>" +
+				"<hr>",
+		},
+		{
+			name: "table",
+			doc:  Doc().Table(HeaderRow("Name"), Row(Cell(Text("api")).Background("#deebff"))),
+			want: "<table><tbody><tr><th><p>Name</p></th></tr>" +
+				`<tr><td style="background-color: #deebff"><p>api</p></td></tr></tbody></table>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToHTML(tt.doc.Build()); got != tt.want {
+				t.Errorf("ToHTML() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToHTML_Sanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  *Document
+		want string
+	}{
+		{
+			name: "text is escaped",
+			doc:  Doc().Paragraph(Text(`<script>alert("x")</script>`)).Heading(1, "a & b"),
+			want: "<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;</p><h1>a &amp; b</h1>",
+		},
+		{
+			name: "javascript link is dropped",
+			doc:  Doc().Paragraph(Link("click", "JavaScript:alert(1)"), Link("me", " javascript:alert(1)")),
+			want: "<p>clickme</p>",
+		},
+		{
+			name: "link with control characters is dropped",
+			doc:  Doc().Paragraph(Link("click", "java\tscript:alert(1)")),
+			want: "<p>click</p>",
+		},
+		{
+			name: "relative and mailto links are kept",
+			doc:  Doc().Paragraph(Link("issue", "/browse/PROJ-1"), Link("mail", "mailto:a@example.com")),
+			want: `<p><a href="/browse/PROJ-1" rel="noopener noreferrer">issue</a><a href="mailto:a@example.com" rel="noopener noreferrer">mail</a></p>`,
+		},
+		{
+			name: "attribute values are escaped",
+			doc:  Doc().Paragraph(Link("x", `https://example.com/"onmouseover="alert(1)`), Mention(`"><b>`, "Mia")),
+			want: `<p><a href="https://example.com/&#34;onmouseover=&#34;alert(1)" rel="noopener noreferrer">x</a>` +
+				`<span class="adf-mention" data-account-id="&#34;&gt;&lt;b&gt;">@Mia</span></p>`,
+		},
+		{
+			name: "unsafe colors are dropped",
+			doc:  Doc().Paragraph(Text("red").Color("red; background: url(x)")),
+			want: "<p>red</p>",
+		},
+		{
+			name: "unsafe code language is dropped",
+			doc:  Doc().CodeBlock(`go"><script>`, "x"),
+			want: "<pre><code>x</code></pre>",
+		},
+		{
+			name: "unknown panel and status types fall back",
+			doc:  Doc().Panel(`"><script>`, Paragraph(Text("x"))).Paragraph(Status("NEW", `"><b>`)),
+			want: `<div class="adf-panel adf-panel-info"><p>x</p></div><p><span class="adf-status adf-status-neutral">NEW</span></p>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToHTML(tt.doc.Build()); got != tt.want {
+				t.Errorf("ToHTML() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToHTML_Media(t *testing.T) {
+	doc := responsetypes.AtlassianDocumentFormat{
+		Type:    "doc",
+		Version: 1,
+		Content: []responsetypes.DocumentNode{
+			{Type: "mediaSingle", Content: []responsetypes.NodeContent{{Type: "media", Attrs: &responsetypes.NodeAttrs{Type: "file", ID: "f1", Alt: "screenshot.png"}}}},
+			{Type: "mediaSingle", Content: []responsetypes.NodeContent{{Type: "media", Attrs: &responsetypes.NodeAttrs{Type: "external", URL: "https://example.com/a.png", Alt: "chart"}}}},
+			{Type: "mediaSingle", Content: []responsetypes.NodeContent{{Type: "media", Attrs: &responsetypes.NodeAttrs{Type: "external", URL: "javascript:alert(1)"}}}},
+			{Type: "extension", Attrs: &responsetypes.NodeAttrs{ExtensionKey: "macro"}},
+			{Type: "bodiedExtension", Content: []responsetypes.NodeContent{{Type: "paragraph", Content: []responsetypes.NodeContent{{Type: "text", Text: "kept"}}}}},
+		},
+	}
+
+	want := `<div class="adf-media"><span class="adf-media-placeholder">[media: screenshot.png]</span></div>` +
+		`<div class="adf-media"><img src="https://example.com/a.png" alt="chart"></div>` +
+		`<div class="adf-media"><span class="adf-media-placeholder">[media: ]</span></div>` +
+		"<p>kept</p>"
+	if got := ToHTML(doc); got != want {
+		t.Errorf("ToHTML() =\n%s\nwant\n%s", got, want)
+	}
+}
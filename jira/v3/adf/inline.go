@@ -0,0 +1,200 @@
+package adf
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// Inline is the content of paragraphs, headings and table cells: text, mentions, emoji, status lozenges and dates
+type Inline interface {
+	inlineNodes() []responsetypes.NodeContent
+}
+
+// TextNode is a run of text with formatting marks, created with Text or Link
+type TextNode struct {
+	text  string
+	marks []responsetypes.Mark
+}
+
+// Text creates a run of plain text. Line breaks in text become hard breaks.
+func Text(text string) TextNode {
+	return TextNode{text: text}
+}
+
+// Link creates a run of text linking to href, or plain text when href is empty
+func Link(text, href string) TextNode {
+	return Text(text).Link(href)
+}
+
+// Bold marks the text as strong
+func (t TextNode) Bold() TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeStrong})
+}
+
+// Italic marks the text as emphasized
+func (t TextNode) Italic() TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeEm})
+}
+
+// Strike marks the text as struck through
+func (t TextNode) Strike() TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeStrike})
+}
+
+// Underline marks the text as underlined
+func (t TextNode) Underline() TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeUnderline})
+}
+
+// Code marks the text as inline code. Inline code can only be combined with a link,
+// so other marks are dropped from the output.
+func (t TextNode) Code() TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeCode})
+}
+
+// Color sets the color of the text, as a hex code such as "#ff5630"
+func (t TextNode) Color(color string) TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeTextColor, Attrs: &responsetypes.MarkAttrs{Color: color}})
+}
+
+// Link makes the text a link to href. An empty href removes the link.
+func (t TextNode) Link(href string) TextNode {
+	if href == "" {
+		return t.withoutMark(responsetypes.MarkTypeLink)
+	}
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeLink, Attrs: &responsetypes.MarkAttrs{Href: href}})
+}
+
+// Sub marks the text as subscript
+func (t TextNode) Sub() TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeSubSup, Attrs: &responsetypes.MarkAttrs{Type: SUBSUP_SUB}})
+}
+
+// Sup marks the text as superscript
+func (t TextNode) Sup() TextNode {
+	return t.withMark(responsetypes.Mark{Type: responsetypes.MarkTypeSubSup, Attrs: &responsetypes.MarkAttrs{Type: SUBSUP_SUP}})
+}
+
+// withMark returns a copy of the text with mark added, replacing a mark of the same type
+func (t TextNode) withMark(mark responsetypes.Mark) TextNode {
+	return TextNode{text: t.text, marks: append(t.withoutMark(mark.Type).marks, mark)}
+}
+
+// withoutMark returns a copy of the text without the mark of the given type
+func (t TextNode) withoutMark(markType string) TextNode {
+	return TextNode{text: t.text, marks: slices.DeleteFunc(slices.Clone(t.marks), func(m responsetypes.Mark) bool { return m.Type == markType })}
+}
+
+// inlineNodes returns a text node per line, separated by hard breaks. Empty text produces no nodes.
+func (t TextNode) inlineNodes() []responsetypes.NodeContent {
+	marks := t.marks
+	if slices.ContainsFunc(marks, func(m responsetypes.Mark) bool { return m.Type == responsetypes.MarkTypeCode }) {
+		marks = slices.DeleteFunc(slices.Clone(marks), func(m responsetypes.Mark) bool {
+			return m.Type != responsetypes.MarkTypeCode && m.Type != responsetypes.MarkTypeLink
+		})
+	}
+
+	var nodes []responsetypes.NodeContent
+	for i, line := range strings.Split(t.text, "\n") {
+		if i > 0 {
+			nodes = append(nodes, responsetypes.NodeContent{Type: responsetypes.NodeTypeHardBreak})
+		}
+		if line != "" {
+			nodes = append(nodes, responsetypes.NodeContent{Type: responsetypes.NodeTypeText, Text: line, Marks: slices.Clone(marks)})
+		}
+	}
+	return nodes
+}
+
+// inlineNode is an inline node without marks. A node with no type, e.g. a mention without an account ID, is left out.
+type inlineNode responsetypes.NodeContent
+
+func (n inlineNode) inlineNodes() []responsetypes.NodeContent {
+	if n.Type == "" {
+		return nil
+	}
+	return []responsetypes.NodeContent{responsetypes.NodeContent(n)}
+}
+
+// Mention creates a mention of the user with the given account ID, displayed as "@displayName".
+// A mention without an account ID is left out.
+func Mention(accountID, displayName string) Inline {
+	if accountID == "" {
+		return inlineNode{}
+	}
+	if displayName != "" && !strings.HasPrefix(displayName, "@") {
+		displayName = "@" + displayName
+	}
+	return inlineNode{
+		Type:  responsetypes.NodeTypeMention,
+		Attrs: &responsetypes.NodeAttrs{ID: accountID, Text: displayName},
+	}
+}
+
+// Emoji creates an emoji from its short name, with or without colons, e.g. ":tada:" or "tada".
+// An emoji without a name is left out.
+func Emoji(shortName string) Inline {
+	shortName = strings.Trim(shortName, ":")
+	if shortName == "" {
+		return inlineNode{}
+	}
+	shortName = ":" + shortName + ":"
+	return inlineNode{
+		Type:  responsetypes.NodeTypeEmoji,
+		Attrs: &responsetypes.NodeAttrs{ShortName: shortName},
+	}
+}
+
+// Status creates a status lozenge, color is one of the STATUS_COLOR_* constants and defaults to neutral.
+// A status without text is left out.
+func Status(text, color string) Inline {
+	if text == "" {
+		return inlineNode{}
+	}
+	if color == "" {
+		color = STATUS_COLOR_NEUTRAL
+	}
+	return inlineNode{
+		Type:  responsetypes.NodeTypeStatus,
+		Attrs: &responsetypes.NodeAttrs{Text: text, Color: color},
+	}
+}
+
+// Date creates a date, displayed in the reader's locale
+func Date(t time.Time) Inline {
+	return inlineNode{
+		Type:  responsetypes.NodeTypeDate,
+		Attrs: &responsetypes.NodeAttrs{Timestamp: strconv.FormatInt(t.UnixMilli(), 10)},
+	}
+}
+
+// HardBreak creates a line break
+func HardBreak() Inline {
+	return inlineNode{Type: responsetypes.NodeTypeHardBreak}
+}
+
+// InlineCard creates a smart link to url, e.g. another issue or a Confluence page. A card without a url is left out.
+func InlineCard(url string) Inline {
+	if url == "" {
+		return inlineNode{}
+	}
+	return inlineNode{
+		Type:  responsetypes.NodeTypeInlineCard,
+		Attrs: &responsetypes.NodeAttrs{URL: url},
+	}
+}
+
+// inlineContent flattens inline content into nodes
+func inlineContent(content []Inline) []responsetypes.NodeContent {
+	var nodes []responsetypes.NodeContent
+	for _, inline := range content {
+		if inline != nil {
+			nodes = append(nodes, inline.inlineNodes()...)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,136 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestInline(t *testing.T) {
+	tests := []struct {
+		name   string
+		inline Inline
+		want   string
+	}{
+		{
+			name:   "plain text",
+			inline: Text("ok"),
+			want:   `[{"type":"text","text":"ok"}]`,
+		},
+		{
+			name:   "empty text",
+			inline: Text(""),
+			want:   `null`,
+		},
+		{
+			name:   "marks",
+			inline: Text("ok").Bold().Italic(),
+			want:   `[{"type":"text","text":"ok","marks":[{"type":"strong"},{"type":"em"}]}]`,
+		},
+		{
+			name:   "link replaces link",
+			inline: Link("docs", "https://a.example").Link("https://b.example"),
+			want:   `[{"type":"text","text":"docs","marks":[{"type":"link","attrs":{"href":"https://b.example"}}]}]`,
+		},
+		{
+			name:   "code keeps only link",
+			inline: Text("make").Bold().Code().Link("https://example.com").Color("#ff5630"),
+			want:   `[{"type":"text","text":"make","marks":[{"type":"code"},{"type":"link","attrs":{"href":"https://example.com"}}]}]`,
+		},
+		{
+			name:   "line breaks",
+			inline: Text("a\nb").Strike(),
+			want:   `[{"type":"text","text":"a","marks":[{"type":"strike"}]},{"type":"hardBreak"},{"type":"text","text":"b","marks":[{"type":"strike"}]}]`,
+		},
+		{
+			name:   "superscript",
+			inline: Text("2").Sup(),
+			want:   `[{"type":"text","text":"2","marks":[{"type":"subsup","attrs":{"type":"sup"}}]}]`,
+		},
+		{
+			name:   "mention",
+			inline: Mention("5b10a2844c20165700ede21g", "Mia Krystof"),
+			want:   `[{"type":"mention","attrs":{"id":"5b10a2844c20165700ede21g","text":"@Mia Krystof"}}]`,
+		},
+		{
+			name:   "emoji",
+			inline: Emoji("tada"),
+			want:   `[{"type":"emoji","attrs":{"shortName":":tada:"}}]`,
+		},
+		{
+			name:   "status",
+			inline: Status("DONE", STATUS_COLOR_GREEN),
+			want:   `[{"type":"status","attrs":{"text":"DONE","color":"green"}}]`,
+		},
+		{
+			name:   "status default color",
+			inline: Status("TODO", ""),
+			want:   `[{"type":"status","attrs":{"text":"TODO","color":"neutral"}}]`,
+		},
+		{
+			name:   "date",
+			inline: Date(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+			want:   `[{"type":"date","attrs":{"timestamp":"1709251200000"}}]`,
+		},
+		{
+			name:   "inline card",
+			inline: InlineCard("https://your-domain.atlassian.net/browse/PROJ-1"),
+			want:   `[{"type":"inlineCard","attrs":{"url":"https://your-domain.atlassian.net/browse/PROJ-1"}}]`,
+		},
+		{
+			name:   "link without href is plain text",
+			inline: Link("docs", "").Bold(),
+			want:   `[{"type":"text","text":"docs","marks":[{"type":"strong"}]}]`,
+		},
+		{
+			name:   "empty href removes link",
+			inline: Link("docs", "https://a.example").Link(""),
+			want:   `[{"type":"text","text":"docs"}]`,
+		},
+		{
+			name:   "mention without account ID",
+			inline: Mention("", "Mia"),
+			want:   `null`,
+		},
+		{
+			name:   "status without text",
+			inline: Status("", STATUS_COLOR_RED),
+			want:   `null`,
+		},
+		{
+			name:   "emoji without name",
+			inline: Emoji("::"),
+			want:   `null`,
+		},
+		{
+			name:   "inline card without url",
+			inline: InlineCard(""),
+			want:   `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.inline.inlineNodes())
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("inline = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextNode_Immutable(t *testing.T) {
+	base := Text("ok")
+	bold := base.Bold()
+	_ = bold.Italic()
+
+	if len(base.marks) != 0 {
+		t.Errorf("base marks = %v, want none", base.marks)
+	}
+	if len(bold.marks) != 1 {
+		t.Errorf("bold marks = %v, want one", bold.marks)
+	}
+}
@@ -0,0 +1,489 @@
+package adf
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// markdownMarkOrder is the nesting order of marks written to Markdown, outermost first.
+// Marks without a Markdown equivalent, such as textColor and underline, are dropped.
+var markdownMarkOrder = []string{
+	responsetypes.MarkTypeLink,
+	responsetypes.MarkTypeStrong,
+	responsetypes.MarkTypeEm,
+	responsetypes.MarkTypeStrike,
+}
+
+// markdownAlerts maps panel types to GitHub alert types, written as "> [!NOTE]"
+var markdownAlerts = map[string]string{
+	PANEL_TYPE_INFO:    "NOTE",
+	PANEL_TYPE_NOTE:    "IMPORTANT",
+	PANEL_TYPE_SUCCESS: "TIP",
+	PANEL_TYPE_WARNING: "WARNING",
+	PANEL_TYPE_ERROR:   "CAUTION",
+}
+
+// markdownEscaper escapes the characters that start inline Markdown syntax
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `~`, `\~`, `<`, `\<`,
+)
+
+// markdownLineStart matches the start of a line that would be read as a block, such as a heading or a list item
+var markdownLineStart = regexp.MustCompile(`^(#{1,6}(?:[ \t]|$)|>|[-+=]|\d{1,9}[.)](?:[ \t]|$))`)
+
+// ToMarkdown converts a document to GitHub Flavored Markdown.
+// Mentions are written as "@name", dates as YYYY-MM-DD, status lozenges as "[TEXT]", panels as GitHub alerts,
+// and media as an image when it has a URL or a "[media: name]" placeholder otherwise.
+func ToMarkdown(doc responsetypes.AtlassianDocumentFormat) string {
+	return markdownBlocks(documentContent(doc))
+}
+
+// markdownBlocks writes blocks separated by blank lines
+func markdownBlocks(nodes []responsetypes.NodeContent) string {
+	var blocks []string
+	for _, node := range nodes {
+		if block := markdownBlock(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// markdownBlock writes a block without a trailing line break
+func markdownBlock(node responsetypes.NodeContent) string {
+	a := attrs(node)
+	switch node.Type {
+	case responsetypes.NodeTypeParagraph:
+		return escapeLineStarts(markdownInline(node.Content))
+	case responsetypes.NodeTypeHeading:
+		return strings.Repeat("#", min(max(a.Level, 1), 6)) + " " + markdownInline(node.Content)
+	case responsetypes.NodeTypeBulletList, responsetypes.NodeTypeDecisionList:
+		return markdownList(node.Content, func(int) string { return "- " })
+	case responsetypes.NodeTypeOrderedList:
+		start := max(a.Order, 1)
+		return markdownList(node.Content, func(i int) string { return strconv.Itoa(start+i) + ". " })
+	case responsetypes.NodeTypeTaskList:
+		return markdownList(node.Content, func(i int) string {
+			if i < len(node.Content) && attrs(node.Content[i]).State == "DONE" {
+				return "- [x] "
+			}
+			return "- [ ] "
+		})
+	case responsetypes.NodeTypeCodeBlock:
+		return markdownCodeBlock(a.Language, plainText(node.Content))
+	case responsetypes.NodeTypeBlockquote:
+		return quoteLines(markdownBlocks(node.Content))
+	case responsetypes.NodeTypePanel:
+		alert, ok := markdownAlerts[a.PanelType]
+		if !ok {
+			alert = markdownAlerts[PANEL_TYPE_INFO]
+		}
+		return quoteLines("[!" + alert + "]\n" + markdownBlocks(node.Content))
+	case responsetypes.NodeTypeRule:
+		return "---"
+	case responsetypes.NodeTypeTable:
+		return markdownTable(node)
+	case responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeMediaGroup:
+		var media []string
+		for _, child := range node.Content {
+			media = append(media, markdownMedia(child))
+		}
+		return strings.Join(media, "\n")
+	case responsetypes.NodeTypeMedia:
+		return markdownMedia(node)
+	case responsetypes.NodeTypeExpand, responsetypes.NodeTypeNestedExpand:
+		title := markdownEscaper.Replace(a.Title)
+		if title == "" {
+			return markdownBlocks(node.Content)
+		}
+		return strings.TrimSuffix("**"+title+"**\n\n"+markdownBlocks(node.Content), "\n\n")
+	case responsetypes.NodeTypeBlockCard, responsetypes.NodeTypeEmbedCard:
+		return "<" + a.URL + ">"
+	case responsetypes.NodeTypeTaskItem, responsetypes.NodeTypeDecisionItem:
+		return escapeLineStarts(markdownInline(node.Content))
+	}
+
+	// Unknown blocks, e.g. extensions, keep their content
+	if isInlineContent(node.Content) {
+		return escapeLineStarts(markdownInline(node.Content))
+	}
+	return markdownBlocks(node.Content)
+}
+
+// markdownList writes the items of a list, indenting their content under the marker
+func markdownList(items []responsetypes.NodeContent, marker func(i int) string) string {
+	lines := make([]string, 0, len(items))
+	for i, item := range items {
+		prefix := marker(i)
+
+		var content string
+		if isInlineContent(item.Content) {
+			// Task and decision items hold inline content directly
+			content = escapeLineStarts(markdownInline(item.Content))
+		} else {
+			content = markdownListItem(item.Content)
+		}
+
+		indent := strings.Repeat(" ", len(prefix))
+		if strings.HasPrefix(prefix, "- [") {
+			indent = "  "
+		}
+		lines = append(lines, prefix+indentLines(content, indent))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownListItem writes the blocks of a list item, keeping nested lists tight under the text
+func markdownListItem(nodes []responsetypes.NodeContent) string {
+	var b strings.Builder
+	for i, node := range nodes {
+		block := markdownBlock(node)
+		if i > 0 {
+			if isList(node) {
+				b.WriteString("\n")
+			} else {
+				b.WriteString("\n\n")
+			}
+		}
+		b.WriteString(block)
+	}
+	return b.String()
+}
+
+// markdownCodeBlock writes a fenced code block, with a fence longer than any backtick run in the code
+func markdownCodeBlock(language, code string) string {
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	code = strings.TrimSuffix(code, "\n")
+	if code == "" {
+		return fence + language + "\n" + fence
+	}
+	return fence + language + "\n" + code + "\n" + fence
+}
+
+// markdownTable writes a GFM table, the first row is always the header row
+func markdownTable(node responsetypes.NodeContent) string {
+	var rows [][]string
+	columns := 0
+	for _, row := range node.Content {
+		var cells []string
+		for _, cell := range row.Content {
+			text := markdownBlocks(cell.Content)
+			text = strings.ReplaceAll(text, "\\\n", " ")
+			text = strings.ReplaceAll(text, "\n\n", " ")
+			text = strings.ReplaceAll(text, "\n", " ")
+			cells = append(cells, strings.ReplaceAll(text, "|", `\|`))
+		}
+		rows = append(rows, cells)
+		columns = max(columns, len(cells))
+	}
+	if len(rows) == 0 || columns == 0 {
+		return ""
+	}
+
+	writeRow := func(b *strings.Builder, cells []string) {
+		b.WriteString("|")
+		for i := 0; i < columns; i++ {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			b.WriteString(" " + cell + " |")
+		}
+	}
+
+	var b strings.Builder
+	writeRow(&b, rows[0])
+	b.WriteString("\n|" + strings.Repeat(" --- |", columns))
+	for _, row := range rows[1:] {
+		b.WriteString("\n")
+		writeRow(&b, row)
+	}
+	return b.String()
+}
+
+// markdownMedia writes an image for external media and a placeholder for attachments
+func markdownMedia(node responsetypes.NodeContent) string {
+	a := attrs(node)
+	if a.URL != "" {
+		return "![" + markdownEscaper.Replace(a.Alt) + "](" + markdownURL(a.URL) + ")"
+	}
+
+	name := a.Alt
+	if name == "" {
+		name = a.ID
+	}
+	return markdownEscaper.Replace("[media: " + name + "]")
+}
+
+// markdownInline writes inline content, keeping marks shared by adjacent text nodes open
+func markdownInline(nodes []responsetypes.NodeContent) string {
+	var b strings.Builder
+	var open []responsetypes.Mark
+
+	// closeTo closes the open marks down to the first depth marks, keeping trailing spaces outside of them
+	closeTo := func(depth int) {
+		if len(open) <= depth {
+			return
+		}
+		text := b.String()
+		trimmed := strings.TrimRight(text, " ")
+		b.Reset()
+		b.WriteString(trimmed)
+		for i := len(open) - 1; i >= depth; i-- {
+			b.WriteString(closingDelimiter(open[i]))
+		}
+		b.WriteString(text[len(trimmed):])
+		open = open[:depth]
+	}
+
+	for _, node := range mergeTextNodes(nodes) {
+		if node.Type != responsetypes.NodeTypeText {
+			closeTo(0)
+			b.WriteString(markdownInlineNode(node))
+			continue
+		}
+
+		marks := markdownMarks(node.Marks)
+		depth := 0
+		for depth < len(open) && depth < len(marks) && reflect.DeepEqual(open[depth], marks[depth]) {
+			depth++
+		}
+		closeTo(depth)
+
+		text := node.Text
+		code := slices.ContainsFunc(node.Marks, func(m responsetypes.Mark) bool { return m.Type == responsetypes.MarkTypeCode })
+		if !code {
+			text = markdownEscaper.Replace(text)
+		}
+
+		// Leading spaces go before the opening delimiters, emphasis can't start with a space
+		if depth < len(marks) {
+			trimmed := strings.TrimLeft(text, " ")
+			b.WriteString(text[:len(text)-len(trimmed)])
+			text = trimmed
+			for _, mark := range marks[depth:] {
+				b.WriteString(openingDelimiter(mark))
+			}
+			open = append(open, marks[depth:]...)
+		}
+
+		if code {
+			text = codeSpan(strings.ReplaceAll(text, "\n", " "))
+		}
+		b.WriteString(strings.ReplaceAll(text, "\n", "\\\n"))
+	}
+	closeTo(0)
+
+	return b.String()
+}
+
+// markdownInlineNode writes an inline node other than text
+func markdownInlineNode(node responsetypes.NodeContent) string {
+	a := attrs(node)
+	switch node.Type {
+	case responsetypes.NodeTypeHardBreak:
+		return "\\\n"
+	case responsetypes.NodeTypeMention:
+		name := a.Text
+		if name == "" {
+			name = a.ID
+		}
+		if !strings.HasPrefix(name, "@") {
+			name = "@" + name
+		}
+		return markdownEscaper.Replace(name)
+	case responsetypes.NodeTypeEmoji:
+		if a.Text != "" {
+			return markdownEscaper.Replace(a.Text)
+		}
+		return markdownEscaper.Replace(a.ShortName)
+	case responsetypes.NodeTypeStatus:
+		return markdownEscaper.Replace("[" + a.Text + "]")
+	case responsetypes.NodeTypeDate:
+		return formatTimestamp(a.Timestamp)
+	case responsetypes.NodeTypeInlineCard:
+		return "<" + a.URL + ">"
+	case responsetypes.NodeTypeMediaInline, responsetypes.NodeTypeMedia:
+		return markdownMedia(node)
+	}
+
+	if a.Text != "" {
+		return markdownEscaper.Replace(a.Text)
+	}
+	return markdownInline(node.Content)
+}
+
+// markdownMarks returns the marks with a Markdown equivalent in nesting order
+func markdownMarks(marks []responsetypes.Mark) []responsetypes.Mark {
+	var ordered []responsetypes.Mark
+	for _, markType := range markdownMarkOrder {
+		for _, mark := range marks {
+			if mark.Type == markType {
+				ordered = append(ordered, mark)
+			}
+		}
+	}
+	return ordered
+}
+
+// openingDelimiter returns the Markdown opening a mark
+func openingDelimiter(mark responsetypes.Mark) string {
+	switch mark.Type {
+	case responsetypes.MarkTypeLink:
+		return "["
+	case responsetypes.MarkTypeStrong:
+		return "**"
+	case responsetypes.MarkTypeEm:
+		return "*"
+	case responsetypes.MarkTypeStrike:
+		return "~~"
+	}
+	return ""
+}
+
+// closingDelimiter returns the Markdown closing a mark
+func closingDelimiter(mark responsetypes.Mark) string {
+	if mark.Type == responsetypes.MarkTypeLink {
+		href := ""
+		if mark.Attrs != nil {
+			href = mark.Attrs.Href
+		}
+		return "](" + markdownURL(href) + ")"
+	}
+	return openingDelimiter(mark)
+}
+
+// markdownURL writes a link destination, in angle brackets when it contains spaces or parentheses
+func markdownURL(url string) string {
+	if strings.ContainsAny(url, " ()<>") {
+		return "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(url) + ">"
+	}
+	return url
+}
+
+// codeSpan wraps text in enough backticks to hold the backtick runs it contains
+func codeSpan(text string) string {
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		return fence + " " + text + " " + fence
+	}
+	return fence + text + fence
+}
+
+// mergeTextNodes joins adjacent text nodes with the same marks
+func mergeTextNodes(nodes []responsetypes.NodeContent) []responsetypes.NodeContent {
+	var merged []responsetypes.NodeContent
+	for _, node := range nodes {
+		if n := len(merged); n > 0 && node.Type == responsetypes.NodeTypeText && merged[n-1].Type == responsetypes.NodeTypeText &&
+			reflect.DeepEqual(merged[n-1].Marks, node.Marks) {
+			merged[n-1].Text += node.Text
+			continue
+		}
+		merged = append(merged, node)
+	}
+	return merged
+}
+
+// escapeLineStarts escapes the start of every line that would otherwise be read as a block
+func escapeLineStarts(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if loc := markdownLineStart.FindStringIndex(line); loc != nil {
+			// Escape the character that makes the line a block: the first one, or the dot of an ordered list marker
+			at := 0
+			if line[0] >= '0' && line[0] <= '9' {
+				at = strings.IndexAny(line, ".)")
+			}
+			lines[i] = line[:at] + `\` + line[at:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// quoteLines prefixes every line with "> "
+func quoteLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = ">"
+		} else {
+			lines[i] = "> " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// indentLines indents every line but the first, leaving blank lines empty
+func indentLines(text, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = indent + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isList reports whether a node is a list
+func isList(node responsetypes.NodeContent) bool {
+	switch node.Type {
+	case responsetypes.NodeTypeBulletList, responsetypes.NodeTypeOrderedList, responsetypes.NodeTypeTaskList, responsetypes.NodeTypeDecisionList:
+		return true
+	}
+	return false
+}
+
+// isInlineContent reports whether nodes are inline nodes rather than blocks
+func isInlineContent(nodes []responsetypes.NodeContent) bool {
+	for _, node := range nodes {
+		switch node.Type {
+		case responsetypes.NodeTypeText, responsetypes.NodeTypeHardBreak, responsetypes.NodeTypeMention, responsetypes.NodeTypeEmoji,
+			responsetypes.NodeTypeStatus, responsetypes.NodeTypeDate, responsetypes.NodeTypeInlineCard, responsetypes.NodeTypeMediaInline,
+			responsetypes.NodeTypeInlineExtension:
+		default:
+			return false
+		}
+	}
+	return len(nodes) > 0
+}
+
+// plainText concatenates the text of nodes, e.g. the content of a code block
+func plainText(nodes []responsetypes.NodeContent) string {
+	var b strings.Builder
+	for _, node := range nodes {
+		b.WriteString(node.Text)
+		b.WriteString(plainText(node.Content))
+	}
+	return b.String()
+}
+
+// formatTimestamp formats a date node timestamp, milliseconds since the epoch, as YYYY-MM-DD in UTC
+func formatTimestamp(timestamp string) string {
+	ms, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return timestamp
+	}
+	return time.UnixMilli(ms).UTC().Format(time.DateOnly)
+}
+
+// markdownPanelType returns the panel type of a GitHub alert type
+func markdownPanelType(alert string) (string, bool) {
+	for panelType, a := range markdownAlerts {
+		if strings.EqualFold(a, alert) {
+			return panelType, true
+		}
+	}
+	return "", false
+}
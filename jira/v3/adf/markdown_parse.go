@@ -0,0 +1,825 @@
+package adf
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+var (
+	mdATXHeading     = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	mdSetextHeading  = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	mdThematicBreak  = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	mdFence          = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*?)[ \t]*$")
+	mdBlockquote     = regexp.MustCompile(`^ {0,3}> ?`)
+	mdListItem       = regexp.MustCompile(`^( {0,3})([-+*]|\d{1,9}[.)])([ \t]+|$)`)
+	mdTableDelimiter = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	mdAlert          = regexp.MustCompile(`^\[!([A-Za-z]+)\][ \t]*$`)
+	mdImage          = regexp.MustCompile(`^!\[((?:[^\]\\]|\\.)*)\]\(([^()\s]+|<[^<>]*>)\)$`)
+	mdAutolink       = regexp.MustCompile(`^<((?:[a-zA-Z][a-zA-Z0-9+.-]{1,31}:[^\s<>]*)|(?:[a-zA-Z0-9.!#$%&'*+/=?^_{|}~-]+@[a-zA-Z0-9-]+(?:\.[a-zA-Z0-9-]+)*))>`)
+)
+
+// FromMarkdown parses CommonMark with the GFM table and strikethrough extensions into a document.
+// Headings, paragraphs, hard breaks, emphasis, strong, strikethrough, inline code, links, autolinks,
+// bulleted and numbered lists, block quotes, fenced code, rules and tables are supported.
+// GitHub alerts such as "> [!WARNING]" become panels and a paragraph holding only an image becomes external media.
+// Blocks ADF doesn't allow in quotes and list items are rewritten: headings become bold paragraphs,
+// nested quotes are unwrapped, tables become a paragraph per row and rules are left out.
+// Raw HTML is kept as text.
+func FromMarkdown(markdown string) *Document {
+	markdown = strings.ReplaceAll(markdown, "\r\n", "\n")
+	return &Document{content: parseMarkdownBlocks(strings.Split(markdown, "\n"))}
+}
+
+// parseMarkdownBlocks parses lines into blocks
+func parseMarkdownBlocks(lines []string) []responsetypes.NodeContent {
+	var nodes []responsetypes.NodeContent
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case isBlank(line):
+			i++
+
+		case mdFence.MatchString(line):
+			var node responsetypes.NodeContent
+			node, i = parseFencedCode(lines, i)
+			nodes = append(nodes, node)
+
+		case mdATXHeading.MatchString(line):
+			m := mdATXHeading.FindStringSubmatch(line)
+			nodes = append(nodes, responsetypes.NodeContent{
+				Type:    responsetypes.NodeTypeHeading,
+				Attrs:   &responsetypes.NodeAttrs{Level: len(m[1])},
+				Content: parseMarkdownInline(m[2]),
+			})
+			i++
+
+		case mdThematicBreak.MatchString(line):
+			nodes = append(nodes, responsetypes.NodeContent{Type: responsetypes.NodeTypeRule})
+			i++
+
+		case mdBlockquote.MatchString(line):
+			var quoted []string
+			for ; i < len(lines) && mdBlockquote.MatchString(lines[i]); i++ {
+				quoted = append(quoted, mdBlockquote.ReplaceAllString(lines[i], ""))
+			}
+			nodes = append(nodes, parseBlockquote(quoted))
+
+		case mdListItem.MatchString(line):
+			var node responsetypes.NodeContent
+			node, i = parseList(lines, i)
+			nodes = append(nodes, node)
+
+		case i+1 < len(lines) && strings.Contains(line, "|") && strings.Contains(lines[i+1], "|") && mdTableDelimiter.MatchString(lines[i+1]):
+			var node responsetypes.NodeContent
+			node, i = parseTable(lines, i)
+			nodes = append(nodes, node)
+
+		default:
+			var node responsetypes.NodeContent
+			node, i = parseParagraph(lines, i)
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// parseFencedCode parses a fenced code block starting at lines[start], returning the index after it
+func parseFencedCode(lines []string, start int) (responsetypes.NodeContent, int) {
+	m := mdFence.FindStringSubmatch(lines[start])
+	indent, fence, info := len(m[1]), m[2], m[3]
+
+	var code []string
+	i := start + 1
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" && leadingSpaces(lines[i]) < 4 {
+			i++
+			break
+		}
+		line := lines[i]
+		line = line[min(indent, leadingSpaces(line)):]
+		code = append(code, line)
+	}
+
+	node := responsetypes.NodeContent{Type: responsetypes.NodeTypeCodeBlock}
+	if language, _, _ := strings.Cut(info, " "); language != "" {
+		node.Attrs = &responsetypes.NodeAttrs{Language: language}
+	}
+	if text := strings.Join(code, "\n"); text != "" {
+		node.Content = []responsetypes.NodeContent{{Type: responsetypes.NodeTypeText, Text: text}}
+	}
+	return node, i
+}
+
+// parseBlockquote parses the lines of a block quote, without their ">" prefix.
+// A quote starting with a GitHub alert becomes a panel.
+func parseBlockquote(lines []string) responsetypes.NodeContent {
+	if len(lines) > 0 {
+		if m := mdAlert.FindStringSubmatch(strings.TrimSpace(lines[0])); m != nil {
+			if panelType, ok := markdownPanelType(m[1]); ok {
+				content := nestedBlocks(parseMarkdownBlocks(lines[1:]), panelNodeTypes)
+				return containerBlock(responsetypes.NodeTypePanel, &responsetypes.NodeAttrs{PanelType: panelType}, blocksOf(content)).node()
+			}
+		}
+	}
+	return containerBlock(responsetypes.NodeTypeBlockquote, nil, blocksOf(nestedBlocks(parseMarkdownBlocks(lines), blockquoteNodeTypes))).node()
+}
+
+// nestedBlocks rewrites the blocks ADF doesn't allow in a quote, list item or panel into allowed ones:
+// headings become bold paragraphs, nested quotes and panels are unwrapped, tables become a paragraph per row
+// and rules are left out
+func nestedBlocks(nodes []responsetypes.NodeContent, allowed []string) []responsetypes.NodeContent {
+	var blocks []responsetypes.NodeContent
+	for _, node := range nodes {
+		switch node.Type {
+		case responsetypes.NodeTypeHeading:
+			node = responsetypes.NodeContent{Type: responsetypes.NodeTypeParagraph, Content: boldInline(node.Content)}
+		case responsetypes.NodeTypeBlockquote, responsetypes.NodeTypePanel:
+			blocks = append(blocks, nestedBlocks(node.Content, allowed)...)
+			continue
+		case responsetypes.NodeTypeTable:
+			for _, row := range node.Content {
+				blocks = append(blocks, tableRowParagraph(row))
+			}
+			continue
+		}
+		if slices.Contains(allowed, node.Type) {
+			blocks = append(blocks, node)
+		}
+	}
+	return blocks
+}
+
+// boldInline returns a copy of inline nodes with the text marked strong, except inline code which can't be
+func boldInline(nodes []responsetypes.NodeContent) []responsetypes.NodeContent {
+	bold := slices.Clone(nodes)
+	for i, node := range bold {
+		if node.Type == responsetypes.NodeTypeText && !slices.ContainsFunc(node.Marks, func(m responsetypes.Mark) bool { return m.Type == responsetypes.MarkTypeCode }) {
+			bold[i].Marks = sortMarks(withMark(node.Marks, responsetypes.Mark{Type: responsetypes.MarkTypeStrong}))
+		}
+	}
+	return bold
+}
+
+// tableRowParagraph joins the cells of a parsed table row into a paragraph, with header cells in bold
+func tableRowParagraph(row responsetypes.NodeContent) responsetypes.NodeContent {
+	paragraph := responsetypes.NodeContent{Type: responsetypes.NodeTypeParagraph}
+	for i, cell := range row.Content {
+		if i > 0 {
+			paragraph.Content = append(paragraph.Content, responsetypes.NodeContent{Type: responsetypes.NodeTypeText, Text: " | "})
+		}
+		for _, block := range cell.Content {
+			if cell.Type == responsetypes.NodeTypeTableHeader {
+				paragraph.Content = append(paragraph.Content, boldInline(block.Content)...)
+			} else {
+				paragraph.Content = append(paragraph.Content, block.Content...)
+			}
+		}
+	}
+	return paragraph
+}
+
+// parseList parses a bulleted or numbered list starting at lines[start], returning the index after it
+func parseList(lines []string, start int) (responsetypes.NodeContent, int) {
+	first := mdListItem.FindStringSubmatch(lines[start])
+	bullet := listDelimiter(first[2])
+
+	node := responsetypes.NodeContent{Type: responsetypes.NodeTypeBulletList}
+	if bullet != "-" && bullet != "+" && bullet != "*" {
+		node.Type = responsetypes.NodeTypeOrderedList
+		if order, _ := strconv.Atoi(strings.TrimRight(first[2], ".)")); order != 1 {
+			node.Attrs = &responsetypes.NodeAttrs{Order: max(order, 0)}
+		}
+	}
+
+	i := start
+	for i < len(lines) {
+		m := mdListItem.FindStringSubmatch(lines[i])
+		if m == nil || listDelimiter(m[2]) != bullet {
+			break
+		}
+
+		// The content of the item starts after the marker, continuation lines are indented to that column
+		contentIndent := len(m[0])
+		if m[3] == "" || len(m[3]) > 4 {
+			contentIndent = len(m[1]) + len(m[2]) + 1
+		}
+		item := []string{strings.TrimLeft(lines[i][len(m[0]):], " ")}
+		if len(m[3]) > 4 {
+			item[0] = lines[i][contentIndent:]
+		}
+
+		i++
+		for i < len(lines) {
+			line := lines[i]
+			if isBlank(line) {
+				// A blank line continues the item only when the next line is indented under it
+				next := i + 1
+				for next < len(lines) && isBlank(lines[next]) {
+					next++
+				}
+				if next < len(lines) && leadingSpaces(lines[next]) >= contentIndent {
+					item = append(item, lines[i:next]...)
+					i = next
+					continue
+				}
+				break
+			}
+			if leadingSpaces(line) >= contentIndent {
+				item = append(item, line[contentIndent:])
+				i++
+				continue
+			}
+			if mdListItem.MatchString(line) || startsBlock(line) {
+				break
+			}
+			// Lazy continuation of the item's paragraph
+			item = append(item, line)
+			i++
+		}
+
+		content := nestedBlocks(parseMarkdownBlocks(item), listItemNodeTypes)
+		if len(content) == 0 || (content[0].Type != responsetypes.NodeTypeParagraph && content[0].Type != responsetypes.NodeTypeCodeBlock &&
+			content[0].Type != responsetypes.NodeTypeMediaSingle) {
+			content = append([]responsetypes.NodeContent{{Type: responsetypes.NodeTypeParagraph}}, content...)
+		}
+		node.Content = append(node.Content, responsetypes.NodeContent{Type: responsetypes.NodeTypeListItem, Content: content})
+
+		// A blank line between items keeps the list going
+		if i < len(lines) && isBlank(lines[i]) {
+			next := i + 1
+			for next < len(lines) && isBlank(lines[next]) {
+				next++
+			}
+			if next < len(lines) {
+				if m := mdListItem.FindStringSubmatch(lines[next]); m != nil && listDelimiter(m[2]) == bullet {
+					i = next
+				}
+			}
+		}
+	}
+	return node, i
+}
+
+// listDelimiter returns the character identifying the list of a marker: the bullet, or the delimiter of a number
+func listDelimiter(marker string) string {
+	return marker[len(marker)-1:]
+}
+
+// parseTable parses a GFM table starting at lines[start], the header row, returning the index after it
+func parseTable(lines []string, start int) (responsetypes.NodeContent, int) {
+	header := splitTableRow(lines[start])
+	columns := len(header)
+
+	node := responsetypes.NodeContent{
+		Type:    responsetypes.NodeTypeTable,
+		Attrs:   &responsetypes.NodeAttrs{Layout: TABLE_LAYOUT_DEFAULT},
+		Content: []responsetypes.NodeContent{tableRow(responsetypes.NodeTypeTableHeader, header, columns)},
+	}
+
+	i := start + 2
+	for ; i < len(lines) && !isBlank(lines[i]) && !startsBlock(lines[i]); i++ {
+		node.Content = append(node.Content, tableRow(responsetypes.NodeTypeTableCell, splitTableRow(lines[i]), columns))
+	}
+	return node, i
+}
+
+// tableRow creates a row of exactly columns cells of the given type
+func tableRow(cellType string, cells []string, columns int) responsetypes.NodeContent {
+	row := responsetypes.NodeContent{Type: responsetypes.NodeTypeTableRow}
+	for i := 0; i < columns; i++ {
+		var content []responsetypes.NodeContent
+		if i < len(cells) {
+			content = parseMarkdownInline(cells[i])
+		}
+		row.Content = append(row.Content, responsetypes.NodeContent{
+			Type:    cellType,
+			Content: []responsetypes.NodeContent{{Type: responsetypes.NodeTypeParagraph, Content: content}},
+		})
+	}
+	return row
+}
+
+// splitTableRow splits a table row on unescaped pipes, unescaping "\|" in the cells
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// parseParagraph parses a paragraph starting at lines[start], returning the index after it.
+// A paragraph followed by a "===" or "---" line is a heading, and one holding only an image with a url is media.
+func parseParagraph(lines []string, start int) (responsetypes.NodeContent, int) {
+	text := []string{strings.TrimLeft(lines[start], " \t")}
+	i := start + 1
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if m := mdSetextHeading.FindStringSubmatch(line); m != nil {
+			level := 1
+			if m[1][0] == '-' {
+				level = 2
+			}
+			return responsetypes.NodeContent{
+				Type:    responsetypes.NodeTypeHeading,
+				Attrs:   &responsetypes.NodeAttrs{Level: level},
+				Content: parseMarkdownInline(strings.Join(text, "\n")),
+			}, i + 1
+		}
+		if isBlank(line) || startsBlock(line) {
+			break
+		}
+		// Only lists starting at 1 interrupt a paragraph, so "2020. A year" stays text
+		if m := mdListItem.FindStringSubmatch(line); m != nil && m[3] != "" && (!isDigit(m[2][0]) || strings.TrimRight(m[2], ".)") == "1") {
+			break
+		}
+		text = append(text, strings.TrimLeft(line, " \t"))
+	}
+
+	joined := strings.TrimRight(strings.Join(text, "\n"), " \t")
+	if m := mdImage.FindStringSubmatch(joined); m != nil && strings.Trim(m[2], "<>") != "" {
+		url := strings.TrimSuffix(strings.TrimPrefix(m[2], "<"), ">")
+		return responsetypes.NodeContent{
+			Type:  responsetypes.NodeTypeMediaSingle,
+			Attrs: &responsetypes.NodeAttrs{Layout: "center"},
+			Content: []responsetypes.NodeContent{{
+				Type:  responsetypes.NodeTypeMedia,
+				Attrs: &responsetypes.NodeAttrs{Type: "external", URL: url, Alt: unescapeMarkdown(m[1])},
+			}},
+		}, i
+	}
+
+	return responsetypes.NodeContent{Type: responsetypes.NodeTypeParagraph, Content: parseMarkdownInline(joined)}, i
+}
+
+// startsBlock reports whether a line starts a block that interrupts a paragraph
+func startsBlock(line string) bool {
+	return mdFence.MatchString(line) || mdATXHeading.MatchString(line) || mdThematicBreak.MatchString(line) || mdBlockquote.MatchString(line)
+}
+
+// parseMarkdownInline parses inline Markdown into inline nodes
+func parseMarkdownInline(text string) []responsetypes.NodeContent {
+	p := &inlineParser{}
+	p.parse(text, nil)
+	p.flush()
+	return mergeTextNodes(p.nodes)
+}
+
+// inlineParser collects the inline nodes of a paragraph
+type inlineParser struct {
+	nodes []responsetypes.NodeContent
+	text  strings.Builder
+	marks []responsetypes.Mark
+}
+
+// flush adds the pending text as a text node
+func (p *inlineParser) flush() {
+	if p.text.Len() == 0 {
+		return
+	}
+	p.nodes = append(p.nodes, responsetypes.NodeContent{
+		Type:  responsetypes.NodeTypeText,
+		Text:  p.text.String(),
+		Marks: sortMarks(p.marks),
+	})
+	p.text.Reset()
+}
+
+// add adds a node other than pending text
+func (p *inlineParser) add(node responsetypes.NodeContent) {
+	p.flush()
+	p.nodes = append(p.nodes, node)
+}
+
+// parse parses text with the given marks applied
+func (p *inlineParser) parse(text string, marks []responsetypes.Mark) {
+	p.flush()
+	outer := p.marks
+	p.marks = marks
+	defer func() {
+		p.flush()
+		p.marks = outer
+	}()
+
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && text[i+1] == '\n':
+			p.add(responsetypes.NodeContent{Type: responsetypes.NodeTypeHardBreak})
+			i += 2
+
+		case c == '\\' && i+1 < len(text) && isASCIIPunct(text[i+1]):
+			p.text.WriteByte(text[i+1])
+			i += 2
+
+		case c == '\n':
+			// Two trailing spaces make a hard break, any other line break is a space
+			if strings.HasSuffix(p.text.String(), "  ") {
+				trimmed := strings.TrimRight(p.text.String(), " ")
+				p.text.Reset()
+				p.text.WriteString(trimmed)
+				p.add(responsetypes.NodeContent{Type: responsetypes.NodeTypeHardBreak})
+			} else {
+				p.text.WriteByte(' ')
+			}
+			i++
+
+		case c == '`':
+			n := runLength(text, i)
+			end := findBacktickRun(text, i+n, n)
+			if end < 0 {
+				p.text.WriteString(text[i : i+n])
+				i += n
+				continue
+			}
+			code := strings.ReplaceAll(text[i+n:end], "\n", " ")
+			if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+				code = code[1 : len(code)-1]
+			}
+			p.flush()
+			codeMarks := []responsetypes.Mark{{Type: responsetypes.MarkTypeCode}}
+			for _, mark := range p.marks {
+				if mark.Type == responsetypes.MarkTypeLink {
+					codeMarks = append(codeMarks, mark)
+				}
+			}
+			p.nodes = append(p.nodes, responsetypes.NodeContent{Type: responsetypes.NodeTypeText, Text: code, Marks: sortMarks(codeMarks)})
+			i = end + n
+
+		case c == '!' && i+1 < len(text) && text[i+1] == '[':
+			// Images inside text become links to the image
+			if label, href, end, ok := parseLink(text, i+1); ok {
+				p.parse(label, withLink(p.marks, href))
+				i = end
+				continue
+			}
+			p.text.WriteByte(c)
+			i++
+
+		case c == '[':
+			if label, href, end, ok := parseLink(text, i); ok {
+				p.parse(label, withLink(p.marks, href))
+				i = end
+				continue
+			}
+			p.text.WriteByte(c)
+			i++
+
+		case c == '<':
+			if m := mdAutolink.FindStringSubmatch(text[i:]); m != nil {
+				href := m[1]
+				if !strings.Contains(href, ":") {
+					href = "mailto:" + href
+				}
+				p.flush()
+				p.nodes = append(p.nodes, responsetypes.NodeContent{
+					Type:  responsetypes.NodeTypeText,
+					Text:  m[1],
+					Marks: sortMarks(withMark(p.marks, linkMark(href))),
+				})
+				i += len(m[0])
+				continue
+			}
+			p.text.WriteByte(c)
+			i++
+
+		case c == '*' || c == '_' || c == '~':
+			if inner, mark, end, ok := parseEmphasis(text, i); ok {
+				p.parse(inner, withMark(p.marks, mark))
+				i = end
+				continue
+			}
+			n := runLength(text, i)
+			p.text.WriteString(text[i : i+n])
+			i += n
+
+		default:
+			p.text.WriteByte(c)
+			i++
+		}
+	}
+}
+
+// parseLink parses "[label](destination)" starting at the "[" at text[start],
+// returning the label, the destination and the index after the link
+func parseLink(text string, start int) (label, href string, end int, ok bool) {
+	depth := 0
+	closing := -1
+	for i := start; i < len(text) && closing < 0; i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '`':
+			n := runLength(text, i)
+			if e := findBacktickRun(text, i+n, n); e >= 0 {
+				i = e + n - 1
+			} else {
+				i += n - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closing = i
+			}
+		}
+	}
+	if closing < 0 || closing+1 >= len(text) || text[closing+1] != '(' {
+		return "", "", 0, false
+	}
+
+	i := closing + 2
+	for i < len(text) && text[i] == ' ' {
+		i++
+	}
+	if i < len(text) && text[i] == '<' {
+		e := strings.IndexAny(text[i:], ">\n")
+		if e < 0 || text[i+e] != '>' {
+			return "", "", 0, false
+		}
+		href = text[i+1 : i+e]
+		i += e + 1
+	} else {
+		parens := 0
+		from := i
+		for ; i < len(text); i++ {
+			c := text[i]
+			if c == '\\' && i+1 < len(text) {
+				i++
+				continue
+			}
+			if c == ' ' || c == '\n' || (c == ')' && parens == 0) {
+				break
+			}
+			if c == '(' {
+				parens++
+			} else if c == ')' {
+				parens--
+			}
+		}
+		href = unescapeMarkdown(text[from:i])
+	}
+
+	// An optional title is ignored, ADF links have no title
+	for i < len(text) && (text[i] == ' ' || text[i] == '\n') {
+		i++
+	}
+	if i < len(text) && (text[i] == '"' || text[i] == '\'') {
+		quote := text[i]
+		e := strings.IndexByte(text[i+1:], quote)
+		if e < 0 {
+			return "", "", 0, false
+		}
+		i += e + 2
+		for i < len(text) && text[i] == ' ' {
+			i++
+		}
+	}
+	if i >= len(text) || text[i] != ')' {
+		return "", "", 0, false
+	}
+	return text[start+1 : closing], href, i + 1, true
+}
+
+// parseEmphasis parses emphasis, strong emphasis or strikethrough opened by the delimiter run at text[start],
+// returning the content, its mark and the index after the closing delimiters
+func parseEmphasis(text string, start int) (inner string, mark responsetypes.Mark, end int, ok bool) {
+	c := text[start]
+	n := runLength(text, start)
+	if !canOpen(text, start, n) {
+		return "", mark, 0, false
+	}
+
+	var uses []int
+	switch {
+	case c == '~' && n == 2:
+		uses = []int{2}
+	case c == '~':
+		return "", mark, 0, false
+	case n >= 2:
+		uses = []int{2, 1}
+	default:
+		uses = []int{1}
+	}
+
+	for _, use := range uses {
+		closeAt, closeLen := findCloser(text, start+n, c, use)
+		if closeAt < 0 {
+			continue
+		}
+
+		switch {
+		case c == '~':
+			mark = responsetypes.Mark{Type: responsetypes.MarkTypeStrike}
+		case use == 2:
+			mark = responsetypes.Mark{Type: responsetypes.MarkTypeStrong}
+		default:
+			mark = responsetypes.Mark{Type: responsetypes.MarkTypeEm}
+		}
+		// The opener uses the first characters of its run and the closer the last characters of its run
+		return text[start+use : closeAt+closeLen-use], mark, closeAt + closeLen, true
+	}
+	return "", mark, 0, false
+}
+
+// findCloser finds a run of c able to close emphasis, at least use long, skipping nested emphasis and code spans.
+// It returns the start and length of the run, or -1.
+func findCloser(text string, from int, c byte, use int) (int, int) {
+	depth := 0
+	for i := from; i < len(text); {
+		switch text[i] {
+		case '\\':
+			i += 2
+			continue
+		case '`':
+			n := runLength(text, i)
+			if e := findBacktickRun(text, i+n, n); e >= 0 {
+				i = e + n
+			} else {
+				i += n
+			}
+			continue
+		case c:
+			n := runLength(text, i)
+			opens, closes := canOpen(text, i, n), canClose(text, i, n)
+			switch {
+			case closes && depth > 0:
+				depth--
+			case closes && n >= use:
+				return i, n
+			case opens:
+				depth++
+			}
+			i += n
+			continue
+		}
+		i++
+	}
+	return -1, 0
+}
+
+// canOpen reports whether the delimiter run text[i:i+n] is left-flanking and so can open emphasis
+func canOpen(text string, i, n int) bool {
+	before, after := runeBefore(text, i), runeAfter(text, i+n)
+	leftFlanking := !unicode.IsSpace(after) && (!isPunct(after) || unicode.IsSpace(before) || isPunct(before))
+	if text[i] == '_' {
+		rightFlanking := !unicode.IsSpace(before) && (!isPunct(before) || unicode.IsSpace(after) || isPunct(after))
+		return leftFlanking && (!rightFlanking || isPunct(before))
+	}
+	return leftFlanking
+}
+
+// canClose reports whether the delimiter run text[i:i+n] is right-flanking and so can close emphasis
+func canClose(text string, i, n int) bool {
+	before, after := runeBefore(text, i), runeAfter(text, i+n)
+	rightFlanking := !unicode.IsSpace(before) && (!isPunct(before) || unicode.IsSpace(after) || isPunct(after))
+	if text[i] == '_' {
+		leftFlanking := !unicode.IsSpace(after) && (!isPunct(after) || unicode.IsSpace(before) || isPunct(before))
+		return rightFlanking && (!leftFlanking || isPunct(after))
+	}
+	return rightFlanking
+}
+
+// runeBefore returns the rune before text[i], or a space at the start of the text
+func runeBefore(text string, i int) rune {
+	if i == 0 {
+		return ' '
+	}
+	r, _ := utf8.DecodeLastRuneInString(text[:i])
+	return r
+}
+
+// runeAfter returns the rune at text[i], or a space at the end of the text
+func runeAfter(text string, i int) rune {
+	if i >= len(text) {
+		return ' '
+	}
+	r, _ := utf8.DecodeRuneInString(text[i:])
+	return r
+}
+
+// runLength returns the number of times the byte at text[i] repeats from i
+func runLength(text string, i int) int {
+	n := 1
+	for i+n < len(text) && text[i+n] == text[i] {
+		n++
+	}
+	return n
+}
+
+// findBacktickRun returns the index of the next run of exactly n backticks from text[from], or -1
+func findBacktickRun(text string, from, n int) int {
+	for i := from; i < len(text); {
+		if text[i] != '`' {
+			i++
+			continue
+		}
+		run := runLength(text, i)
+		if run == n {
+			return i
+		}
+		i += run
+	}
+	return -1
+}
+
+// unescapeMarkdown removes backslash escapes
+func unescapeMarkdown(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) && isASCIIPunct(text[i+1]) {
+			i++
+		}
+		b.WriteByte(text[i])
+	}
+	return b.String()
+}
+
+// linkMark creates a link mark
+func linkMark(href string) responsetypes.Mark {
+	return responsetypes.Mark{Type: responsetypes.MarkTypeLink, Attrs: &responsetypes.MarkAttrs{Href: href}}
+}
+
+// withLink returns marks with a link to href added, or marks as they are when href is empty, e.g. "[text]()"
+func withLink(marks []responsetypes.Mark, href string) []responsetypes.Mark {
+	if href == "" {
+		return marks
+	}
+	return withMark(marks, linkMark(href))
+}
+
+// withMark returns a copy of marks with mark added, replacing a mark of the same type
+func withMark(marks []responsetypes.Mark, mark responsetypes.Mark) []responsetypes.Mark {
+	marks = slices.DeleteFunc(slices.Clone(marks), func(m responsetypes.Mark) bool { return m.Type == mark.Type })
+	return append(marks, mark)
+}
+
+// sortMarks returns a copy of marks in the order written by ToMarkdown, with inline code last
+func sortMarks(marks []responsetypes.Mark) []responsetypes.Mark {
+	if len(marks) == 0 {
+		return nil
+	}
+	order := append(slices.Clone(markdownMarkOrder), responsetypes.MarkTypeCode)
+	sorted := slices.Clone(marks)
+	slices.SortStableFunc(sorted, func(a, b responsetypes.Mark) int {
+		return slices.Index(order, a.Type) - slices.Index(order, b.Type)
+	})
+	return sorted
+}
+
+// blocksOf wraps nodes as blocks
+func blocksOf(nodes []responsetypes.NodeContent) []Block {
+	blocks := make([]Block, 0, len(nodes))
+	for _, node := range nodes {
+		blocks = append(blocks, blockNode(node))
+	}
+	return blocks
+}
+
+// isBlank reports whether a line holds only whitespace
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// leadingSpaces returns the number of spaces a line starts with
+func leadingSpaces(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isASCIIPunct reports whether c is ASCII punctuation, the characters that can be backslash-escaped
+func isASCIIPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("$+<=>^`|~", c) >= 0
+}
+
+// isPunct reports whether r is punctuation for the flanking rules
+func isPunct(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
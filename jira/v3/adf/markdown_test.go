@@ -0,0 +1,383 @@
+package adf
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+func TestToMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  *Document
+		want string
+	}{
+		{
+			name: "empty",
+			doc:  Doc(),
+			want: "",
+		},
+		{
+			name: "headings and marks",
+			doc: Doc().
+				Heading(1, "Release").
+				Paragraph(Text("Build "), Text("passed").Bold(), Text(" on "), Text("main").Code(), Text(", see "), Link("logs", "https://ci.example.com/1")).
+				Paragraph(Text("old").Strike(), Text(" "), Text("new").Italic().Bold()),
+			want: "# Release\n\n" +
+				"Build **passed** on `main`, see [logs](https://ci.example.com/1)\n\n" +
+				"~~old~~ ***new***",
+		},
+		{
+			name: "shared marks stay open",
+			doc:  Doc().Paragraph(Text("a ").Bold(), Text("b").Bold().Italic(), Text(" c").Bold()),
+			want: "**a *b* c**",
+		},
+		{
+			name: "spaces move outside of marks",
+			doc:  Doc().Paragraph(Text("see"), Text(" this ").Bold(), Text("now")),
+			want: "see **this** now",
+		},
+		{
+			name: "escaping",
+			doc:  Doc().Paragraph(Text("# not a heading")).Paragraph(Text("2. not a list, a_b*c [d] `e`")).Paragraph(Text("- not an item")),
+			want: "\\# not a heading\n\n2\\. not a list, a\\_b\\*c \\[d\\] \\`e\\`\n\n\\- not an item",
+		},
+		{
+			name: "hard breaks",
+			doc:  Doc().Paragraph(Text("line one\nline two")),
+			want: "line one\\\nline two",
+		},
+		{
+			name: "inline nodes",
+			doc: Doc().Paragraph(
+				Mention("abc", "Mia Krystof"), Text(" "), Emoji("tada"), Text(" "), Status("DONE", STATUS_COLOR_GREEN), Text(" "),
+				Date(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)), Text(" "), InlineCard("https://example.com/PROJ-1"),
+			),
+			want: "@Mia Krystof :tada: \\[DONE\\] 2024-03-01 <https://example.com/PROJ-1>",
+		},
+		{
+			name: "lists",
+			doc: Doc().
+				BulletList(Item(Text("one")).With(OrderedList(Items("a", "b")...)), Item(Text("two"))).
+				Append(OrderedListFrom(3, Item(Text("three")).With(CodeBlock("", "x := 1")))),
+			want: "- one\n  1. a\n  2. b\n- two\n\n" +
+				"3. three\n\n   ```\n   x := 1\n   ```",
+		},
+		{
+			name: "code block",
+			doc:  Doc().CodeBlock("go", "fmt.Println(\"```\")"),
+			want: "````go\nfmt.Println(\"```\")\n````",
+		},
+		{
+			name: "quote, panel and rule",
+			doc:  Doc().Blockquote(Paragraph(Text("quoted")), Paragraph(Text("twice"))).Panel(PANEL_TYPE_WARNING, Paragraph(Text("careful"))).Rule(),
+			want: "> quoted\n>\n> twice\n\n> [!WARNING]\n> careful\n\n---",
+		},
+		{
+			name: "table",
+			doc:  Doc().Table(HeaderRow("Name", "Notes"), TextRow("api", "a|b"), Row(Cell(Text("worker")))),
+			want: "| Name | Notes |\n| --- | --- |\n| api | a\\|b |\n| worker |  |",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToMarkdown(tt.doc.Build()); got != tt.want {
+				t.Errorf("ToMarkdown() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMarkdown_Media(t *testing.T) {
+	doc := responsetypes.AtlassianDocumentFormat{
+		Type:    "doc",
+		Version: 1,
+		Content: []responsetypes.DocumentNode{
+			{Type: "mediaSingle", Content: []responsetypes.NodeContent{{Type: "media", Attrs: &responsetypes.NodeAttrs{Type: "file", ID: "f1", Alt: "screenshot.png"}}}},
+			{Type: "mediaSingle", Content: []responsetypes.NodeContent{{Type: "media", Attrs: &responsetypes.NodeAttrs{Type: "external", URL: "https://example.com/a.png"}}}},
+		},
+	}
+
+	want := "\\[media: screenshot.png\\]\n\n![](https://example.com/a.png)"
+	if got := ToMarkdown(doc); got != want {
+		t.Errorf("ToMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     *Document
+	}{
+		{
+			name:     "paragraphs and soft breaks",
+			markdown: "first\nline\n\nsecond  \nline",
+			want:     Doc().Text("first line").Paragraph(Text("second"), HardBreak(), Text("line")),
+		},
+		{
+			name:     "emphasis",
+			markdown: "*em* _em_ **strong** __strong__ ~~gone~~ snake_case_name 2 * 3 * 4",
+			want: Doc().Paragraph(
+				Text("em").Italic(), Text(" "), Text("em").Italic(), Text(" "), Text("strong").Bold(), Text(" "),
+				Text("strong").Bold(), Text(" "), Text("gone").Strike(), Text(" snake_case_name 2 * 3 * 4"),
+			),
+		},
+		{
+			name:     "nested emphasis",
+			markdown: "***both*** *a **b** c*",
+			want: Doc().Paragraph(
+				Text("both").Bold().Italic(), Text(" "), Text("a ").Italic(), Text("b").Bold().Italic(), Text(" c").Italic(),
+			),
+		},
+		{
+			name:     "links and code",
+			markdown: "[**docs**](https://example.com \"Docs\") `a*b` [`x`](<https://example.com/a b>) <https://jira.example.com>",
+			want: Doc().Paragraph(
+				Text("docs").Bold().Link("https://example.com"), Text(" "), Text("a*b").Code(), Text(" "),
+				Text("x").Link("https://example.com/a b").Code(), Text(" "), Link("https://jira.example.com", "https://jira.example.com"),
+			),
+		},
+		{
+			name:     "unmatched syntax stays text",
+			markdown: "[not a link] *open `tick <b>",
+			want:     Doc().Text("[not a link] *open `tick <b>"),
+		},
+		{
+			name:     "headings",
+			markdown: "# One #\n\nTwo\n---\n\n###### Six",
+			want:     Doc().Heading(1, "One").Heading(2, "Two").Heading(6, "Six"),
+		},
+		{
+			name:     "loose list with nested content",
+			markdown: "* one\n\n* two\n  continued\n\n  second paragraph\n\n  + nested\n* three",
+			want: Doc().BulletList(
+				Item(Text("one")),
+				Item(Text("two continued")).With(Paragraph(Text("second paragraph")), BulletList(Items("nested")...)),
+				Item(Text("three")),
+			),
+		},
+		{
+			name:     "ordered list",
+			markdown: "7) seven\n8) eight\n\n1. new list",
+			want:     Doc().Append(OrderedListFrom(7, Items("seven", "eight")...)).OrderedList(Items("new list")...),
+		},
+		{
+			name:     "code blocks",
+			markdown: "~~~python extra\nprint(1)\n\n~~~\n```\nunclosed",
+			want:     Doc().CodeBlock("python", "print(1)\n").CodeBlock("", "unclosed"),
+		},
+		{
+			name:     "alerts",
+			markdown: "> [!tip]\n> Use **flags**\n\n> [!UNKNOWN]",
+			want:     Doc().Panel(PANEL_TYPE_SUCCESS, Paragraph(Text("Use "), Text("flags").Bold())).Blockquote(Paragraph(Text("[!UNKNOWN]"))),
+		},
+		{
+			name:     "table",
+			markdown: "Name | Notes\n:--- | ---:\n`api` | a \\| b\nworker",
+			want: Doc().Table(
+				HeaderRow("Name", "Notes"),
+				Row(Cell(Text("api").Code()), Cell(Text("a | b"))),
+				Row(Cell(Text("worker")), Cell()),
+			),
+		},
+		{
+			name:     "image",
+			markdown: "![diagram](https://example.com/d.png)\n\ntext ![icon](https://example.com/i.png)",
+			want: Doc().Append(blockNode{
+				Type:  responsetypes.NodeTypeMediaSingle,
+				Attrs: &responsetypes.NodeAttrs{Layout: "center"},
+				Content: []responsetypes.NodeContent{{
+					Type:  responsetypes.NodeTypeMedia,
+					Attrs: &responsetypes.NodeAttrs{Type: "external", URL: "https://example.com/d.png", Alt: "diagram"},
+				}},
+			}).Paragraph(Text("text "), Link("icon", "https://example.com/i.png")),
+		},
+		{
+			name:     "headings in quotes and list items",
+			markdown: "> # Title `v1`\n> text\n\n* a\n  # b\n* ## c",
+			want: Doc().
+				Blockquote(Paragraph(Text("Title ").Bold(), Text("v1").Code()), Paragraph(Text("text"))).
+				BulletList(Item(Text("a")).With(Paragraph(Text("b").Bold())), Item(Text("c").Bold())),
+		},
+		{
+			name:     "blocks in quotes",
+			markdown: "> > nested\n> ---\n> a | b\n> - | -\n> 1 | 2",
+			want:     Doc().Blockquote(Paragraph(Text("nested")), Paragraph(Text("a").Bold(), Text(" | "), Text("b").Bold()), Paragraph(Text("1 | 2"))),
+		},
+		{
+			name:     "empty link destination",
+			markdown: "[x]() ![y](<>)\n\n![z](<>)",
+			want:     Doc().Text("x y").Text("z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalize(FromMarkdown(tt.markdown).Build())
+			want := normalize(tt.want.Build())
+			if !reflect.DeepEqual(got, want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(want)
+				t.Errorf("FromMarkdown() =\n%s\nwant\n%s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestMarkdown_RoundTrip(t *testing.T) {
+	docs := []*Document{
+		Doc().
+			Heading(2, "Deploy *v1.4*").
+			Paragraph(Text("Status: "), Text("ok").Bold(), Text(", see "), Link("the pipeline", "https://ci.example.com/p?id=1&x=(2)")).
+			Paragraph(Text("a "), Text("b").Bold().Italic(), Text(" c").Bold(), Text(" d"), Text("gone").Strike()).
+			Paragraph(Text("line one\nline two with `ticks`, *stars*, _underscores_ and \\ backslashes")).
+			Paragraph(Text("1. not a list"), Text(" and "), Text("`code`").Code(), Text(" and "), Text("linked").Code().Link("https://example.com")).
+			BulletList(
+				Item(Text("one")).With(OrderedList(Items("a", "b")...)),
+				Item(Text("two")).With(Paragraph(Text("more about two")), CodeBlock("sh", "make test\nmake lint")),
+			).
+			Append(OrderedListFrom(5, Items("five", "six")...)).
+			CodeBlock("go", "func main() {\n\tfmt.Println(\"```\")\n}").
+			Blockquote(Paragraph(Text("quoted")), BulletList(Items("in quote")...)).
+			Panel(PANEL_TYPE_ERROR, Paragraph(Text("failed")), Paragraph(Text("twice"))).
+			Rule().
+			Table(
+				HeaderRow("Service", "Owner"),
+				Row(Cell(Text("api").Code()), Cell(Text("team "), Text("a|b").Italic())),
+			),
+	}
+
+	for i, doc := range docs {
+		want := normalize(doc.Build())
+		markdown := ToMarkdown(want)
+		got := normalize(FromMarkdown(markdown).Build())
+		if !reflect.DeepEqual(got, want) {
+			gotJSON, _ := json.Marshal(got)
+			wantJSON, _ := json.Marshal(want)
+			t.Errorf("doc %d round trip through\n%s\n=\n%s\nwant\n%s", i, markdown, gotJSON, wantJSON)
+		}
+		if again := ToMarkdown(got); again != markdown {
+			t.Errorf("doc %d Markdown changed on the second pass:\n%s\nwant\n%s", i, again, markdown)
+		}
+	}
+}
+
+func TestMarkdown_RoundTripFromMarkdown(t *testing.T) {
+	markdowns := []string{
+		"# Weekly notes\n\nShipped **search** and *filters*, see [PROJ-12](https://jira.example.com/browse/PROJ-12).",
+		"1. Build\n2. Test\n   - unit\n   - integration\n3. Release",
+		"> [!NOTE]\n> Deploys are frozen on Friday.",
+		"```json\n{\"ok\": true}\n```\n\n---\n\n| Step | Time |\n| --- | --- |\n| build | 3m |",
+		"Use `go test ./...`\\\nthen ~~pray~~ push.",
+	}
+
+	for _, markdown := range markdowns {
+		if got := ToMarkdown(FromMarkdown(markdown).Build()); got != markdown {
+			t.Errorf("round trip of\n%s\n=\n%s", markdown, got)
+		}
+	}
+}
+
+// normalize sorts marks and merges adjacent text nodes, so documents can be compared regardless of how marks were applied
+func normalize(doc responsetypes.AtlassianDocumentFormat) responsetypes.AtlassianDocumentFormat {
+	var walk func(nodes []responsetypes.NodeContent) []responsetypes.NodeContent
+	walk = func(nodes []responsetypes.NodeContent) []responsetypes.NodeContent {
+		for i := range nodes {
+			nodes[i].Marks = sortMarks(nodes[i].Marks)
+			nodes[i].Content = walk(nodes[i].Content)
+		}
+		return mergeTextNodes(nodes)
+	}
+	for i := range doc.Content {
+		doc.Content[i].Marks = sortMarks(doc.Content[i].Marks)
+		doc.Content[i].Content = walk(doc.Content[i].Content)
+	}
+	return doc
+}
+
+func TestParse(t *testing.T) {
+	var description interface{}
+	if err := json.Unmarshal([]byte(`{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"hi"}]}]}`), &description); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	doc, err := Parse(description)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := ToMarkdown(doc); got != "hi" {
+		t.Errorf("ToMarkdown(Parse()) = %q, want hi", got)
+	}
+
+	if doc, err := Parse(nil); err != nil || len(doc.Content) != 0 {
+		t.Errorf("Parse(nil) = %+v, %v", doc, err)
+	}
+	if _, err := Parse(map[string]interface{}{"type": "paragraph"}); err == nil {
+		t.Error("Parse() expected error for a non-doc root, got nil")
+	}
+	if _, err := Parse(json.RawMessage(`{`)); err == nil {
+		t.Error("Parse() expected error for invalid JSON, got nil")
+	}
+}
+
+func TestParse_ResizedMediaAndTables(t *testing.T) {
+	// Jira stores resized images and table columns with fractional sizes
+	var description interface{}
+	if err := json.Unmarshal([]byte(`{"type":"doc","version":1,"content":[
+		{"type":"mediaSingle","attrs":{"layout":"center","width":66.67,"widthType":"percentage"},"content":[
+			{"type":"media","attrs":{"type":"external","url":"https://example.com/a.png","width":1024.5,"height":768}}]},
+		{"type":"table","content":[{"type":"tableRow","content":[
+			{"type":"tableCell","attrs":{"colwidth":[150.5]},"content":[{"type":"paragraph","content":[{"type":"text","text":"cell"}]}]}]}]},
+		{"type":"heading","attrs":{"level":"2"},"content":[{"type":"text","text":"odd level"}]}]}`), &description); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	doc, err := Parse(description)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	media := doc.Content[0]
+	if media.Attrs.Width != 66.67 || media.Attrs.Layout != "center" {
+		t.Errorf("mediaSingle attrs = %+v, want width 66.67 and layout center", media.Attrs)
+	}
+	if a := media.Content[0].Attrs; a.Width != 1024.5 || a.Height != 768 || a.URL != "https://example.com/a.png" {
+		t.Errorf("media attrs = %+v, want width 1024.5, height 768 and the URL", a)
+	}
+	if got := doc.Content[1].Content[0].Content[0].Attrs.Colwidth; len(got) != 1 || got[0] != 150.5 {
+		t.Errorf("tableCell colwidth = %v, want [150.5]", got)
+	}
+
+	// An attribute of an unexpected type is dropped, the rest of the document is kept
+	if a := doc.Content[2].Attrs; a == nil || a.Level != 0 {
+		t.Errorf("heading attrs = %+v, want level dropped", a)
+	}
+	if got, want := ToText(doc), "https://example.com/a.png\n\ncell\n\nodd level"; got != want {
+		t.Errorf("ToText(Parse()) = %q, want %q", got, want)
+	}
+}
+
+func FuzzFromMarkdown(f *testing.F) {
+	for _, markdown := range []string{
+		"# Title\n\ntext with **bold**, _em_, ~~strike~~, `code` and [a link](https://example.com)",
+		"> # Title\n> > nested\n> ---\n> [!NOTE]",
+		"> [!WARNING]\n> # Careful\n> > quoted\n> a | b\n> - | -\n> 1 | 2",
+		"* a\n  # b\n  > quoted\n  ---\n* ## c\n\n1. a\n   | x |\n   | - |\n   | y |",
+		"[x]() ![y](<>) <https://example.com> <a@b.c>\n\n![z](<>)\n\n![diagram](https://example.com/d.png)",
+		"Setext\n===\n\n> Setext\n> ---\n\n- Setext\n  ===",
+		"***bold italic*** **`code`** [**`x`**](https://example.com)",
+	} {
+		f.Add(markdown)
+	}
+
+	f.Fuzz(func(t *testing.T, markdown string) {
+		if err := Validate(FromMarkdown(markdown).Build()); err != nil {
+			t.Errorf("Validate(FromMarkdown(%q)) error = %v", markdown, err)
+		}
+	})
+}
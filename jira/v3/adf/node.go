@@ -0,0 +1,72 @@
+package adf
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// Parse converts a decoded ADF value into a document, e.g. an issue description decoded as map[string]interface{}.
+// It accepts an AtlassianDocumentFormat, a *Document, raw JSON or any value that marshals to an ADF document.
+// A nil value is an empty document.
+func Parse(v interface{}) (responsetypes.AtlassianDocumentFormat, error) {
+	switch doc := v.(type) {
+	case nil:
+		return Doc().Build(), nil
+	case responsetypes.AtlassianDocumentFormat:
+		return doc, nil
+	case *responsetypes.AtlassianDocumentFormat:
+		if doc == nil {
+			return Doc().Build(), nil
+		}
+		return *doc, nil
+	case *Document:
+		return doc.Build(), nil
+	}
+
+	var data []byte
+	switch raw := v.(type) {
+	case json.RawMessage:
+		data = raw
+	case []byte:
+		data = raw
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return responsetypes.AtlassianDocumentFormat{}, fmt.Errorf("error encoding ADF: %w", err)
+		}
+	}
+
+	var doc responsetypes.AtlassianDocumentFormat
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return responsetypes.AtlassianDocumentFormat{}, fmt.Errorf("error decoding ADF: %w", err)
+	}
+	if doc.Type != responsetypes.NodeTypeDoc {
+		return responsetypes.AtlassianDocumentFormat{}, fmt.Errorf("ADF root node type is %q, want %q", doc.Type, responsetypes.NodeTypeDoc)
+	}
+	return doc, nil
+}
+
+// documentContent returns the top-level nodes of a document as NodeContent, the type used at every other level
+func documentContent(doc responsetypes.AtlassianDocumentFormat) []responsetypes.NodeContent {
+	nodes := make([]responsetypes.NodeContent, 0, len(doc.Content))
+	for _, node := range doc.Content {
+		nodes = append(nodes, responsetypes.NodeContent{
+			Type:    node.Type,
+			Content: node.Content,
+			Attrs:   node.Attrs,
+			Text:    node.Text,
+			Marks:   node.Marks,
+		})
+	}
+	return nodes
+}
+
+// attrs returns the attributes of a node, or empty attributes when it has none
+func attrs(node responsetypes.NodeContent) responsetypes.NodeAttrs {
+	if node.Attrs == nil {
+		return responsetypes.NodeAttrs{}
+	}
+	return *node.Attrs
+}
@@ -0,0 +1,69 @@
+package adf
+
+import (
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// NodeHandler renders a node in place of the default rendering, e.g. to resolve the account ID of a mention to a
+// display name or to render an extension. content is the content of the node rendered by the same renderer.
+// The result is written as is, so a handler of an HTML renderer must escape the text it writes.
+// Returning false falls back to the default rendering.
+type NodeHandler func(node responsetypes.NodeContent, content string) (string, bool)
+
+// Renderer renders documents as HTML or plain text, created with NewHTMLRenderer or NewTextRenderer.
+// Handlers are registered before rendering, a configured Renderer is safe for concurrent use.
+type Renderer struct {
+	render   func(r *Renderer, nodes []responsetypes.NodeContent) string
+	handlers map[string]NodeHandler
+}
+
+// NewHTMLRenderer creates a renderer writing sanitized HTML, suitable for emails and portals.
+// All text is escaped, links and images keep only http, https and mailto URLs, and colors only hex values.
+func NewHTMLRenderer() *Renderer {
+	return &Renderer{render: (*Renderer).htmlNodes}
+}
+
+// NewTextRenderer creates a renderer writing plain text.
+// Blocks are separated by blank lines, list items are written one per line under "- " or "1. " markers,
+// and links are followed by their URL in parentheses.
+func NewTextRenderer() *Renderer {
+	return &Renderer{render: (*Renderer).textBlocks}
+}
+
+// Handle registers the handler of a node type, such as responsetypes.NodeTypeMention.
+// A nil handler restores the default rendering.
+func (r *Renderer) Handle(nodeType string, handler NodeHandler) *Renderer {
+	if handler == nil {
+		delete(r.handlers, nodeType)
+		return r
+	}
+	if r.handlers == nil {
+		r.handlers = make(map[string]NodeHandler)
+	}
+	r.handlers[nodeType] = handler
+	return r
+}
+
+// Render renders a document
+func (r *Renderer) Render(doc responsetypes.AtlassianDocumentFormat) string {
+	return r.render(r, documentContent(doc))
+}
+
+// ToHTML renders a document as sanitized HTML with the default handlers, see NewHTMLRenderer
+func ToHTML(doc responsetypes.AtlassianDocumentFormat) string {
+	return NewHTMLRenderer().Render(doc)
+}
+
+// ToText renders a document as plain text with the default handlers, see NewTextRenderer
+func ToText(doc responsetypes.AtlassianDocumentFormat) string {
+	return NewTextRenderer().Render(doc)
+}
+
+// handle runs the handler registered for the type of a node, rendering its content only when there is one
+func (r *Renderer) handle(node responsetypes.NodeContent, content func() string) (string, bool) {
+	handler, ok := r.handlers[node.Type]
+	if !ok {
+		return "", false
+	}
+	return handler(node, content())
+}
@@ -0,0 +1,70 @@
+package adf
+
+import (
+	"html"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+func TestRenderer_Handle(t *testing.T) {
+	names := map[string]string{"abc": "Mia <Krystof>"}
+	mention := func(escape func(string) string) NodeHandler {
+		return func(node responsetypes.NodeContent, _ string) (string, bool) {
+			name, ok := names[node.Attrs.ID]
+			if !ok {
+				return "", false
+			}
+			return escape("@" + name), true
+		}
+	}
+	panel := func(node responsetypes.NodeContent, content string) (string, bool) {
+		return strings.ToUpper(node.Attrs.PanelType) + ": " + content, true
+	}
+
+	doc := Doc().
+		Paragraph(Mention("abc", ""), Text(" and "), Mention("def", "Unknown")).
+		Panel(PANEL_TYPE_WARNING, Paragraph(Text("careful"))).
+		Build()
+
+	tests := []struct {
+		name     string
+		renderer *Renderer
+		want     string
+	}{
+		{
+			name:     "html",
+			renderer: NewHTMLRenderer().Handle(responsetypes.NodeTypeMention, mention(html.EscapeString)),
+			want: `<p>@Mia &lt;Krystof&gt; and <span class="adf-mention" data-account-id="def">@Unknown</span></p>` +
+				`<div class="adf-panel adf-panel-warning"><p>careful</p></div>`,
+		},
+		{
+			name:     "html with content",
+			renderer: NewHTMLRenderer().Handle(responsetypes.NodeTypePanel, panel),
+			want:     `<p><span class="adf-mention" data-account-id="abc">@abc</span> and <span class="adf-mention" data-account-id="def">@Unknown</span></p>WARNING: <p>careful</p>`,
+		},
+		{
+			name: "text",
+			renderer: NewTextRenderer().
+				Handle(responsetypes.NodeTypeMention, mention(func(s string) string { return s })).
+				Handle(responsetypes.NodeTypePanel, panel),
+			want: "@Mia <Krystof> and @Unknown\n\nWARNING: careful",
+		},
+		{
+			name: "nil handler restores the default",
+			renderer: NewTextRenderer().
+				Handle(responsetypes.NodeTypePanel, panel).
+				Handle(responsetypes.NodeTypePanel, nil),
+			want: "@abc and @Unknown\n\ncareful",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.renderer.Render(doc); got != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package adf
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// textBlocks renders blocks separated by blank lines
+func (r *Renderer) textBlocks(nodes []responsetypes.NodeContent) string {
+	var blocks []string
+	for _, node := range nodes {
+		if block := r.textBlock(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// textBlock renders a block without a trailing line break
+func (r *Renderer) textBlock(node responsetypes.NodeContent) string {
+	if out, ok := r.handle(node, func() string { return r.textContent(node.Content) }); ok {
+		return out
+	}
+
+	a := attrs(node)
+	switch node.Type {
+	case responsetypes.NodeTypeParagraph, responsetypes.NodeTypeHeading,
+		responsetypes.NodeTypeTaskItem, responsetypes.NodeTypeDecisionItem:
+		return r.textInline(node.Content)
+	case responsetypes.NodeTypeBulletList, responsetypes.NodeTypeDecisionList:
+		return r.textList(node.Content, func(int) string { return "- " })
+	case responsetypes.NodeTypeOrderedList:
+		start := max(a.Order, 1)
+		return r.textList(node.Content, func(i int) string { return strconv.Itoa(start+i) + ". " })
+	case responsetypes.NodeTypeTaskList:
+		return r.textList(node.Content, func(i int) string {
+			if i < len(node.Content) && attrs(node.Content[i]).State == "DONE" {
+				return "[x] "
+			}
+			return "[ ] "
+		})
+	case responsetypes.NodeTypeCodeBlock:
+		return strings.TrimSuffix(plainText(node.Content), "\n")
+	case responsetypes.NodeTypeBlockquote:
+		return quoteLines(r.textBlocks(node.Content))
+	case responsetypes.NodeTypeRule:
+		return "---"
+	case responsetypes.NodeTypeTable:
+		return r.textTable(node)
+	case responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeMediaGroup:
+		var media []string
+		for _, child := range node.Content {
+			media = append(media, r.textInlineNode(child))
+		}
+		return strings.Join(media, "\n")
+	case responsetypes.NodeTypeExpand, responsetypes.NodeTypeNestedExpand:
+		content := r.textBlocks(node.Content)
+		if a.Title == "" || content == "" {
+			return a.Title + content
+		}
+		return a.Title + "\n\n" + content
+	case responsetypes.NodeTypeBlockCard, responsetypes.NodeTypeEmbedCard:
+		return a.URL
+	case responsetypes.NodeTypeExtension:
+		return ""
+	}
+
+	// Unknown blocks, e.g. panels and bodied extensions, keep their content
+	return r.textContent(node.Content)
+}
+
+// textContent renders content that is either inline nodes or blocks
+func (r *Renderer) textContent(nodes []responsetypes.NodeContent) string {
+	if isInlineContent(nodes) {
+		return r.textInline(nodes)
+	}
+	return r.textBlocks(nodes)
+}
+
+// textList renders the items of a list one per line, indenting their content under the marker
+func (r *Renderer) textList(items []responsetypes.NodeContent, marker func(i int) string) string {
+	lines := make([]string, 0, len(items))
+	for i, item := range items {
+		prefix := marker(i)
+
+		var content string
+		if isInlineContent(item.Content) {
+			// Task and decision items hold inline content directly
+			content = r.textInline(item.Content)
+		} else {
+			var blocks []string
+			for _, block := range item.Content {
+				if text := r.textBlock(block); text != "" {
+					blocks = append(blocks, text)
+				}
+			}
+			content = strings.Join(blocks, "\n")
+		}
+		lines = append(lines, prefix+indentLines(content, strings.Repeat(" ", len(prefix))))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// textTable renders a table one row per line with cells separated by " | "
+func (r *Renderer) textTable(node responsetypes.NodeContent) string {
+	var rows []string
+	for _, row := range node.Content {
+		var cells []string
+		for _, cell := range row.Content {
+			cells = append(cells, strings.Join(strings.Fields(r.textContent(cell.Content)), " "))
+		}
+		rows = append(rows, strings.Join(cells, " | "))
+	}
+	return strings.Join(rows, "\n")
+}
+
+// textInline renders inline content, following the text of each link with its URL
+func (r *Renderer) textInline(nodes []responsetypes.NodeContent) string {
+	var b strings.Builder
+	var href, linkText string
+
+	endLink := func() {
+		if href != "" && linkText != href && linkText != strings.TrimPrefix(href, "mailto:") {
+			b.WriteString(" (" + href + ")")
+		}
+		href, linkText = "", ""
+	}
+
+	for _, node := range nodes {
+		nodeHref := ""
+		if node.Type == responsetypes.NodeTypeText {
+			nodeHref = linkHref(node.Marks)
+		}
+		if nodeHref != href {
+			endLink()
+			href = nodeHref
+		}
+
+		text := r.textInlineNode(node)
+		b.WriteString(text)
+		if href != "" {
+			linkText += text
+		}
+	}
+	endLink()
+
+	return b.String()
+}
+
+// textInlineNode renders an inline node
+func (r *Renderer) textInlineNode(node responsetypes.NodeContent) string {
+	if out, ok := r.handle(node, func() string { return r.textInline(node.Content) }); ok {
+		return out
+	}
+
+	a := attrs(node)
+	switch node.Type {
+	case responsetypes.NodeTypeText:
+		return node.Text
+	case responsetypes.NodeTypeHardBreak:
+		return "\n"
+	case responsetypes.NodeTypeMention:
+		name := a.Text
+		if name == "" {
+			name = a.ID
+		}
+		if !strings.HasPrefix(name, "@") {
+			name = "@" + name
+		}
+		return name
+	case responsetypes.NodeTypeEmoji:
+		if a.Text != "" {
+			return a.Text
+		}
+		return a.ShortName
+	case responsetypes.NodeTypeStatus:
+		return "[" + a.Text + "]"
+	case responsetypes.NodeTypeDate:
+		return formatTimestamp(a.Timestamp)
+	case responsetypes.NodeTypeInlineCard:
+		return a.URL
+	case responsetypes.NodeTypeMedia, responsetypes.NodeTypeMediaInline:
+		if a.URL != "" {
+			return a.URL
+		}
+		name := a.Alt
+		if name == "" {
+			name = a.ID
+		}
+		return "[media: " + name + "]"
+	case responsetypes.NodeTypeInlineExtension:
+		return ""
+	}
+
+	if a.Text != "" {
+		return a.Text
+	}
+	return r.textInline(node.Content)
+}
+
+// linkHref returns the URL of the link mark among marks, if any
+func linkHref(marks []responsetypes.Mark) string {
+	for _, mark := range marks {
+		if mark.Type == responsetypes.MarkTypeLink && mark.Attrs != nil {
+			return mark.Attrs.Href
+		}
+	}
+	return ""
+}
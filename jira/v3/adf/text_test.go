@@ -0,0 +1,80 @@
+package adf
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToText(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  *Document
+		want string
+	}{
+		{
+			name: "empty",
+			doc:  Doc(),
+			want: "",
+		},
+		{
+			name: "text nodes are joined without extra spaces",
+			doc:  Doc().Paragraph(Text("Build "), Text("pass").Bold(), Text("ed on "), Text("main").Code(), Text(".")),
+			want: "Build passed on main.",
+		},
+		{
+			name: "blocks are separated by blank lines",
+			doc:  Doc().Heading(1, "Release").Text("line one\nline two").Rule().Text("end"),
+			want: "Release\n\nline one\nline two\n\n---\n\nend",
+		},
+		{
+			name: "links are followed by their URL",
+			doc: Doc().Paragraph(
+				Text("see "), Link("the ", "https://ci.example.com/1"), Link("logs", "https://ci.example.com/1").Bold(), Text(", "),
+				Link("https://example.com", "https://example.com"), Text(" or "), Link("a@example.com", "mailto:a@example.com"),
+			),
+			want: "see the logs (https://ci.example.com/1), https://example.com or a@example.com",
+		},
+		{
+			name: "inline nodes",
+			doc: Doc().Paragraph(
+				Mention("abc", "Mia Krystof"), Text(" "), Emoji("tada"), Text(" "), Status("DONE", STATUS_COLOR_GREEN), Text(" "),
+				Date(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)), Text(" "), InlineCard("https://example.com/PROJ-1"),
+			),
+			want: "@Mia Krystof :tada: [DONE] 2024-03-01 https://example.com/PROJ-1",
+		},
+		{
+			name: "lists",
+			doc: Doc().
+				BulletList(Item(Text("one")).With(OrderedList(Items("a", "b")...)), Item(Text("two\nmore"))).
+				Append(OrderedListFrom(9, Items("nine", "ten")...)),
+			want: "- one\n  1. a\n  2. b\n- two\n  more\n\n" +
+				"9. nine\n10. ten",
+		},
+		{
+			name: "code block keeps whitespace",
+			doc:  Doc().CodeBlock("go", "func main() {\n\tfmt.Println(\"hi\")\n}\n"),
+			want: "func main() {\n\tfmt.Println(\"hi\")\n}",
+		},
+		{
+			name: "quote, panel and expand",
+			doc: Doc().
+				Blockquote(Paragraph(Text("quoted")), Paragraph(Text("twice"))).
+				Panel(PANEL_TYPE_INFO, Paragraph(Text("note"))).
+				Expand("Details", Paragraph(Text("more"))),
+			want: "> quoted\n>\n> twice\n\nnote\n\nDetails\n\nmore",
+		},
+		{
+			name: "table",
+			doc:  Doc().Table(HeaderRow("Name", "Notes"), Row(Cell(Text("api")), Cell(Text("a\nb")))),
+			want: "Name | Notes\napi | a b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToText(tt.doc.Build()); got != tt.want {
+				t.Errorf("ToText() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
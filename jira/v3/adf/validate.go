@@ -0,0 +1,380 @@
+package adf
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// ValidationError is a problem with a node of a document, addressed by its path from the root
+type ValidationError struct {
+	// The path to the node, e.g. "content[2].content[0]" or "content[0].attrs.level"
+	Path string
+
+	// What is wrong with the node, e.g. "text not allowed in bulletList"
+	Message string
+}
+
+// Error returns the path and the message
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors are the problems found by Validate, in document order
+type ValidationErrors []*ValidationError
+
+// Error returns the problems separated by semicolons
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "invalid ADF document: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the problems, so that errors.As finds each *ValidationError
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// nodeSpec describes what a node type may contain
+type nodeSpec struct {
+	// The node types allowed as content, none when the node is a leaf
+	content []string
+
+	// The number of content nodes required, and allowed when max is positive
+	min, max int
+
+	// The marks allowed on the node
+	marks []string
+
+	// Checks the attributes of the node, reporting problems by attribute name
+	attrs func(a responsetypes.NodeAttrs, report func(attr, format string, args ...interface{}))
+}
+
+var (
+	// inlineNodeTypes are the nodes allowed in paragraphs, headings, tasks and decisions
+	inlineNodeTypes = []string{
+		responsetypes.NodeTypeText, responsetypes.NodeTypeHardBreak, responsetypes.NodeTypeMention, responsetypes.NodeTypeEmoji,
+		responsetypes.NodeTypeStatus, responsetypes.NodeTypeDate, responsetypes.NodeTypeInlineCard, responsetypes.NodeTypeMediaInline,
+		responsetypes.NodeTypeInlineExtension,
+	}
+
+	// topLevelNodeTypes are the nodes allowed in a document
+	topLevelNodeTypes = []string{
+		responsetypes.NodeTypeParagraph, responsetypes.NodeTypeHeading, responsetypes.NodeTypeBulletList, responsetypes.NodeTypeOrderedList,
+		responsetypes.NodeTypeCodeBlock, responsetypes.NodeTypeBlockquote, responsetypes.NodeTypePanel, responsetypes.NodeTypeRule,
+		responsetypes.NodeTypeTable, responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeMediaGroup, responsetypes.NodeTypeExpand,
+		responsetypes.NodeTypeTaskList, responsetypes.NodeTypeDecisionList, responsetypes.NodeTypeBlockCard, responsetypes.NodeTypeEmbedCard,
+		responsetypes.NodeTypeExtension, responsetypes.NodeTypeBodiedExtension,
+	}
+
+	// listItemNodeTypes are the nodes allowed in list items
+	listItemNodeTypes = []string{
+		responsetypes.NodeTypeParagraph, responsetypes.NodeTypeBulletList, responsetypes.NodeTypeOrderedList, responsetypes.NodeTypeTaskList,
+		responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeCodeBlock,
+	}
+
+	// blockquoteNodeTypes are the nodes allowed in quotes
+	blockquoteNodeTypes = []string{
+		responsetypes.NodeTypeParagraph, responsetypes.NodeTypeBulletList, responsetypes.NodeTypeOrderedList, responsetypes.NodeTypeCodeBlock,
+		responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeMediaGroup,
+	}
+
+	// panelNodeTypes are the nodes allowed in panels
+	panelNodeTypes = []string{
+		responsetypes.NodeTypeParagraph, responsetypes.NodeTypeHeading, responsetypes.NodeTypeBulletList, responsetypes.NodeTypeOrderedList,
+		responsetypes.NodeTypeBlockCard, responsetypes.NodeTypeMediaGroup, responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeCodeBlock,
+		responsetypes.NodeTypeTaskList, responsetypes.NodeTypeRule, responsetypes.NodeTypeDecisionList,
+	}
+
+	// tableCellNodeTypes are the nodes allowed in table cells
+	tableCellNodeTypes = []string{
+		responsetypes.NodeTypeParagraph, responsetypes.NodeTypeHeading, responsetypes.NodeTypeBulletList, responsetypes.NodeTypeOrderedList,
+		responsetypes.NodeTypeCodeBlock, responsetypes.NodeTypeBlockquote, responsetypes.NodeTypePanel, responsetypes.NodeTypeRule,
+		responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeMediaGroup, responsetypes.NodeTypeTaskList, responsetypes.NodeTypeDecisionList,
+		responsetypes.NodeTypeBlockCard, responsetypes.NodeTypeEmbedCard, responsetypes.NodeTypeNestedExpand, responsetypes.NodeTypeExtension,
+	}
+
+	// expandNodeTypes are the nodes allowed in expands and bodied extensions
+	expandNodeTypes = []string{
+		responsetypes.NodeTypeParagraph, responsetypes.NodeTypePanel, responsetypes.NodeTypeBlockquote, responsetypes.NodeTypeOrderedList,
+		responsetypes.NodeTypeBulletList, responsetypes.NodeTypeRule, responsetypes.NodeTypeHeading, responsetypes.NodeTypeCodeBlock,
+		responsetypes.NodeTypeMediaGroup, responsetypes.NodeTypeMediaSingle, responsetypes.NodeTypeDecisionList, responsetypes.NodeTypeTaskList,
+		responsetypes.NodeTypeTable, responsetypes.NodeTypeBlockCard, responsetypes.NodeTypeEmbedCard, responsetypes.NodeTypeExtension,
+		responsetypes.NodeTypeNestedExpand,
+	}
+
+	// nestedExpandNodeTypes are the nodes allowed in expands nested in tables
+	nestedExpandNodeTypes = []string{
+		responsetypes.NodeTypeParagraph, responsetypes.NodeTypeHeading, responsetypes.NodeTypeMediaGroup, responsetypes.NodeTypeMediaSingle,
+		responsetypes.NodeTypeCodeBlock, responsetypes.NodeTypeBulletList, responsetypes.NodeTypeOrderedList, responsetypes.NodeTypeTaskList,
+		responsetypes.NodeTypeDecisionList, responsetypes.NodeTypeRule, responsetypes.NodeTypePanel, responsetypes.NodeTypeBlockquote,
+	}
+
+	// textMarkTypes are the marks allowed on text
+	textMarkTypes = []string{
+		responsetypes.MarkTypeStrong, responsetypes.MarkTypeEm, responsetypes.MarkTypeStrike, responsetypes.MarkTypeUnderline,
+		responsetypes.MarkTypeCode, responsetypes.MarkTypeLink, responsetypes.MarkTypeTextColor, responsetypes.MarkTypeSubSup,
+		responsetypes.MarkTypeBackgroundColor,
+	}
+
+	// blockMarkTypes are the marks allowed on paragraphs and headings
+	blockMarkTypes = []string{responsetypes.MarkTypeAlignment, responsetypes.MarkTypeIndentation}
+
+	// panelTypes are the types of panels, custom panels have their own color and icon
+	panelTypes = []string{PANEL_TYPE_INFO, PANEL_TYPE_NOTE, PANEL_TYPE_WARNING, PANEL_TYPE_SUCCESS, PANEL_TYPE_ERROR, "custom"}
+
+	// hexColor matches the colors of textColor and backgroundColor marks
+	hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+)
+
+// nodeSpecs are the nesting and attribute rules of the ADF schema, by node type
+var nodeSpecs = map[string]nodeSpec{
+	responsetypes.NodeTypeDoc:       {content: topLevelNodeTypes},
+	responsetypes.NodeTypeParagraph: {content: inlineNodeTypes, marks: blockMarkTypes},
+	responsetypes.NodeTypeHeading: {content: inlineNodeTypes, marks: blockMarkTypes, attrs: func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		if a.Level < 1 || a.Level > 6 {
+			report("level", "heading level must be between 1 and 6")
+		}
+	}},
+	responsetypes.NodeTypeBulletList: {content: []string{responsetypes.NodeTypeListItem}, min: 1},
+	responsetypes.NodeTypeOrderedList: {content: []string{responsetypes.NodeTypeListItem}, min: 1, attrs: func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		if a.Order < 0 {
+			report("order", "orderedList order must not be negative")
+		}
+	}},
+	responsetypes.NodeTypeListItem:   {content: listItemNodeTypes, min: 1},
+	responsetypes.NodeTypeCodeBlock:  {content: []string{responsetypes.NodeTypeText}},
+	responsetypes.NodeTypeBlockquote: {content: blockquoteNodeTypes, min: 1},
+	responsetypes.NodeTypePanel: {content: panelNodeTypes, min: 1, attrs: func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		if !slices.Contains(panelTypes, a.PanelType) {
+			report("panelType", "unknown panel type %q", a.PanelType)
+		}
+	}},
+	responsetypes.NodeTypeRule:        {},
+	responsetypes.NodeTypeTable:       {content: []string{responsetypes.NodeTypeTableRow}, min: 1},
+	responsetypes.NodeTypeTableRow:    {content: []string{responsetypes.NodeTypeTableCell, responsetypes.NodeTypeTableHeader}, min: 1},
+	responsetypes.NodeTypeTableCell:   {content: tableCellNodeTypes, min: 1},
+	responsetypes.NodeTypeTableHeader: {content: tableCellNodeTypes, min: 1},
+	responsetypes.NodeTypeMediaSingle: {content: []string{responsetypes.NodeTypeMedia}, min: 1, max: 1},
+	responsetypes.NodeTypeMediaGroup:  {content: []string{responsetypes.NodeTypeMedia}, min: 1},
+	responsetypes.NodeTypeMedia: {marks: []string{responsetypes.MarkTypeLink}, attrs: func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		switch a.Type {
+		case "external":
+			if a.URL == "" {
+				report("url", "external media requires a url")
+			}
+		case "file", "link":
+			if a.ID == "" {
+				report("id", "%s media requires an id", a.Type)
+			}
+		default:
+			report("type", "unknown media type %q", a.Type)
+		}
+	}},
+	responsetypes.NodeTypeMediaInline:  {attrs: requireAttr("id", func(a responsetypes.NodeAttrs) string { return a.ID })},
+	responsetypes.NodeTypeExpand:       {content: expandNodeTypes, min: 1},
+	responsetypes.NodeTypeNestedExpand: {content: nestedExpandNodeTypes, min: 1},
+	responsetypes.NodeTypeTaskList: {
+		content: []string{responsetypes.NodeTypeTaskItem, responsetypes.NodeTypeTaskList}, min: 1,
+		attrs: requireAttr("localId", func(a responsetypes.NodeAttrs) string { return a.LocalID }),
+	},
+	responsetypes.NodeTypeTaskItem: {content: inlineNodeTypes, attrs: itemAttrs("TODO", "DONE")},
+	responsetypes.NodeTypeDecisionList: {
+		content: []string{responsetypes.NodeTypeDecisionItem}, min: 1,
+		attrs: requireAttr("localId", func(a responsetypes.NodeAttrs) string { return a.LocalID }),
+	},
+	responsetypes.NodeTypeDecisionItem:    {content: inlineNodeTypes, attrs: itemAttrs("DECIDED")},
+	responsetypes.NodeTypeBlockCard:       {attrs: requireAttr("url", func(a responsetypes.NodeAttrs) string { return a.URL })},
+	responsetypes.NodeTypeEmbedCard:       {attrs: requireAttr("url", func(a responsetypes.NodeAttrs) string { return a.URL })},
+	responsetypes.NodeTypeInlineCard:      {attrs: requireAttr("url", func(a responsetypes.NodeAttrs) string { return a.URL })},
+	responsetypes.NodeTypeExtension:       {attrs: extensionAttrs},
+	responsetypes.NodeTypeInlineExtension: {attrs: extensionAttrs},
+	responsetypes.NodeTypeBodiedExtension: {content: expandNodeTypes, min: 1, attrs: extensionAttrs},
+	responsetypes.NodeTypeText:            {marks: textMarkTypes},
+	responsetypes.NodeTypeHardBreak:       {},
+	responsetypes.NodeTypeMention:         {attrs: requireAttr("id", func(a responsetypes.NodeAttrs) string { return a.ID })},
+	responsetypes.NodeTypeEmoji:           {attrs: requireAttr("shortName", func(a responsetypes.NodeAttrs) string { return strings.Trim(a.ShortName, ":") })},
+	responsetypes.NodeTypeStatus: {attrs: func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		if a.Text == "" {
+			report("text", "status requires a text")
+		}
+		if !slices.Contains(statusColors, a.Color) {
+			report("color", "unknown status color %q", a.Color)
+		}
+	}},
+	responsetypes.NodeTypeDate: {attrs: func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		if _, err := strconv.ParseInt(a.Timestamp, 10, 64); err != nil {
+			report("timestamp", "date timestamp must be milliseconds since the epoch, got %q", a.Timestamp)
+		}
+	}},
+}
+
+// requireAttr checks that an attribute is set
+func requireAttr(name string, value func(responsetypes.NodeAttrs) string) func(responsetypes.NodeAttrs, func(string, string, ...interface{})) {
+	return func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		if value(a) == "" {
+			report(name, "%s is required", name)
+		}
+	}
+}
+
+// itemAttrs checks the local ID and the state of a task or decision item
+func itemAttrs(states ...string) func(responsetypes.NodeAttrs, func(string, string, ...interface{})) {
+	return func(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+		if a.LocalID == "" {
+			report("localId", "localId is required")
+		}
+		if !slices.Contains(states, a.State) {
+			report("state", "state must be one of %s, got %q", strings.Join(states, ", "), a.State)
+		}
+	}
+}
+
+// extensionAttrs checks that an extension names its app
+func extensionAttrs(a responsetypes.NodeAttrs, report func(string, string, ...interface{})) {
+	if a.ExtensionType == "" {
+		report("extensionType", "extensionType is required")
+	}
+	if a.ExtensionKey == "" {
+		report("extensionKey", "extensionKey is required")
+	}
+}
+
+// Validate checks a document against the nesting and attribute rules of the ADF schema,
+// catching the mistakes Jira rejects with a bare 400, such as text directly in a document or an empty list item.
+// The error is ValidationErrors, listing every problem with the path of its node.
+// See: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+func Validate(doc responsetypes.AtlassianDocumentFormat) error {
+	v := &validator{}
+	if doc.Type != responsetypes.NodeTypeDoc {
+		v.report("type", "root node type is %q, want %q", doc.Type, responsetypes.NodeTypeDoc)
+	}
+	if doc.Version != ADF_VERSION {
+		v.report("version", "version must be %d", ADF_VERSION)
+	}
+	v.content("", responsetypes.NodeTypeDoc, nodeSpecs[responsetypes.NodeTypeDoc], documentContent(doc))
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// validator collects the problems found in a document
+type validator struct {
+	errs ValidationErrors
+}
+
+// report adds a problem at path
+func (v *validator) report(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// node checks a node and its content
+func (v *validator) node(path string, node responsetypes.NodeContent) {
+	spec, ok := nodeSpecs[node.Type]
+	if !ok || node.Type == responsetypes.NodeTypeDoc {
+		v.report(path, "unknown node type %q", node.Type)
+		return
+	}
+
+	if node.Type == responsetypes.NodeTypeText && node.Text == "" {
+		v.report(path, "text must not be empty")
+	}
+	if spec.attrs != nil {
+		spec.attrs(attrs(node), func(attr, format string, args ...interface{}) {
+			v.report(joinPath(path, "attrs."+attr), format, args...)
+		})
+	}
+	v.marks(path, node, spec.marks)
+	v.content(path, node.Type, spec, node.Content)
+}
+
+// content checks the number and types of the content nodes of a node
+func (v *validator) content(path, nodeType string, spec nodeSpec, content []responsetypes.NodeContent) {
+	if len(content) < spec.min {
+		v.report(path, "%s must not be empty", nodeType)
+	}
+	if spec.max > 0 && len(content) > spec.max {
+		v.report(path, "%s allows at most %d content node(s), got %d", nodeType, spec.max, len(content))
+	}
+
+	for i, child := range content {
+		childPath := joinPath(path, "content["+strconv.Itoa(i)+"]")
+		if _, known := nodeSpecs[child.Type]; known && !slices.Contains(spec.content, child.Type) {
+			v.report(childPath, "%s not allowed in %s", child.Type, nodeType)
+			continue
+		}
+		if nodeType == responsetypes.NodeTypeCodeBlock && len(child.Marks) > 0 {
+			v.report(childPath, "marks not allowed on text in codeBlock")
+			child.Marks = nil
+		}
+		v.node(childPath, child)
+	}
+}
+
+// marks checks the marks of a node: allowed on the node type, set at most once and with valid attributes
+func (v *validator) marks(path string, node responsetypes.NodeContent, allowed []string) {
+	seen := map[string]bool{}
+	for i, mark := range node.Marks {
+		markPath := joinPath(path, "marks["+strconv.Itoa(i)+"]")
+		if !slices.Contains(allowed, mark.Type) {
+			v.report(markPath, "%s mark not allowed on %s", mark.Type, node.Type)
+			continue
+		}
+		if seen[mark.Type] {
+			v.report(markPath, "duplicate %s mark", mark.Type)
+			continue
+		}
+		seen[mark.Type] = true
+
+		var a responsetypes.MarkAttrs
+		if mark.Attrs != nil {
+			a = *mark.Attrs
+		}
+		switch mark.Type {
+		case responsetypes.MarkTypeLink:
+			if a.Href == "" {
+				v.report(markPath, "link mark requires an href")
+			}
+		case responsetypes.MarkTypeTextColor, responsetypes.MarkTypeBackgroundColor:
+			if !hexColor.MatchString(a.Color) {
+				v.report(markPath, "%s mark color must be a hex color such as #ff5630, got %q", mark.Type, a.Color)
+			}
+		case responsetypes.MarkTypeSubSup:
+			if a.Type != SUBSUP_SUB && a.Type != SUBSUP_SUP {
+				v.report(markPath, "subsup mark type must be %q or %q, got %q", SUBSUP_SUB, SUBSUP_SUP, a.Type)
+			}
+		}
+	}
+
+	// Inline code can only be combined with a link
+	if seen[responsetypes.MarkTypeCode] {
+		for i, mark := range node.Marks {
+			if seen[mark.Type] && mark.Type != responsetypes.MarkTypeCode && mark.Type != responsetypes.MarkTypeLink {
+				v.report(joinPath(path, "marks["+strconv.Itoa(i)+"]"), "%s mark can't be combined with code", mark.Type)
+			}
+		}
+	}
+}
+
+// joinPath appends an element to a path
+func joinPath(path, element string) string {
+	if path == "" {
+		return element
+	}
+	return path + "." + element
+}
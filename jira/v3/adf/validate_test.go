@@ -0,0 +1,173 @@
+package adf
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+func TestValidate_Valid(t *testing.T) {
+	docs := map[string]*Document{
+		"empty": Doc(),
+		"builder": Doc().
+			Heading(2, "Deploy").
+			Paragraph(Text("Release ").Bold(), Status("LIVE", STATUS_COLOR_GREEN), Date(time.Now()), Mention("abc", "Mia"), Emoji("rocket"), Link("logs", "https://ci.example.com").Code()).
+			BulletList(Item(Text("api")).With(OrderedListFrom(2, Items("a", "b")...)), Item(Text("worker"))).
+			CodeBlock("go", "x := 1").
+			Blockquote().
+			Panel(PANEL_TYPE_WARNING, Paragraph(Text("careful").Color("#ff5630"))).
+			Expand("Details", Paragraph(Text("H"), Text("2").Sub())).
+			Rule().
+			Table(HeaderRow("Service"), Row(Cell(Text("api")).Background("#deebff"))),
+		"markdown": FromMarkdown("# Title\n\n- [link](https://example.com)\n- ~~old~~\n\n> [!NOTE]\n> info\n\n![chart](https://example.com/a.png)\n\n| a |\n| --- |\n| b |"),
+	}
+
+	for name, doc := range docs {
+		t.Run(name, func(t *testing.T) {
+			if err := Validate(doc.Build()); err != nil {
+				t.Errorf("Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	paragraph := func(content ...responsetypes.NodeContent) responsetypes.DocumentNode {
+		return responsetypes.DocumentNode{Type: "paragraph", Content: content}
+	}
+	text := responsetypes.NodeContent{Type: "text", Text: "x"}
+
+	tests := []struct {
+		name string
+		doc  responsetypes.AtlassianDocumentFormat
+		want []string
+	}{
+		{
+			name: "root type and version",
+			doc:  responsetypes.AtlassianDocumentFormat{Type: "paragraph"},
+			want: []string{`type: root node type is "paragraph", want "doc"`, "version: version must be 1"},
+		},
+		{
+			name: "text in doc",
+			doc:  responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{{Type: "text", Text: "x"}}},
+			want: []string{"content[0]: text not allowed in doc"},
+		},
+		{
+			name: "text in bulletList",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				paragraph(text), paragraph(text), {Type: "bulletList", Content: []responsetypes.NodeContent{text}},
+			}},
+			want: []string{"content[2].content[0]: text not allowed in bulletList"},
+		},
+		{
+			name: "emoji without name",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				paragraph(responsetypes.NodeContent{Type: "emoji", Attrs: &responsetypes.NodeAttrs{ShortName: "::"}}),
+			}},
+			want: []string{"content[0].content[0].attrs.shortName: shortName is required"},
+		},
+		{
+			name: "empty list item",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				{Type: "bulletList", Content: []responsetypes.NodeContent{{Type: "listItem"}}},
+				{Type: "orderedList"},
+			}},
+			want: []string{"content[0].content[0]: listItem must not be empty", "content[1]: orderedList must not be empty"},
+		},
+		{
+			name: "marks on code blocks",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				{Type: "codeBlock", Marks: []responsetypes.Mark{{Type: "strong"}}, Content: []responsetypes.NodeContent{
+					{Type: "text", Text: "x", Marks: []responsetypes.Mark{{Type: "em"}}},
+				}},
+			}},
+			want: []string{"content[0].marks[0]: strong mark not allowed on codeBlock", "content[0].content[0]: marks not allowed on text in codeBlock"},
+		},
+		{
+			name: "unknown node and empty text",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				paragraph(responsetypes.NodeContent{Type: "sparkle"}, responsetypes.NodeContent{Type: "text"}),
+			}},
+			want: []string{`content[0].content[0]: unknown node type "sparkle"`, "content[0].content[1]: text must not be empty"},
+		},
+		{
+			name: "attributes",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				{Type: "heading", Attrs: &responsetypes.NodeAttrs{Level: 7}},
+				{Type: "panel", Attrs: &responsetypes.NodeAttrs{PanelType: "tip"}, Content: []responsetypes.NodeContent{{Type: "paragraph"}}},
+				paragraph(responsetypes.NodeContent{Type: "mention"}, responsetypes.NodeContent{Type: "date", Attrs: &responsetypes.NodeAttrs{Timestamp: "2024-03-01"}}),
+			}},
+			want: []string{
+				"content[0].attrs.level: heading level must be between 1 and 6",
+				`content[1].attrs.panelType: unknown panel type "tip"`,
+				"content[2].content[0].attrs.id: id is required",
+				`content[2].content[1].attrs.timestamp: date timestamp must be milliseconds since the epoch, got "2024-03-01"`,
+			},
+		},
+		{
+			name: "text marks",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				paragraph(
+					responsetypes.NodeContent{Type: "text", Text: "x", Marks: []responsetypes.Mark{{Type: "code"}, {Type: "strong"}}},
+					responsetypes.NodeContent{Type: "text", Text: "x", Marks: []responsetypes.Mark{{Type: "link"}, {Type: "em"}, {Type: "em"}}},
+					responsetypes.NodeContent{Type: "text", Text: "x", Marks: []responsetypes.Mark{{Type: "textColor", Attrs: &responsetypes.MarkAttrs{Color: "red"}}, {Type: "alignment"}}},
+				),
+			}},
+			want: []string{
+				"content[0].content[0].marks[1]: strong mark can't be combined with code",
+				"content[0].content[1].marks[0]: link mark requires an href",
+				"content[0].content[1].marks[2]: duplicate em mark",
+				`content[0].content[2].marks[0]: textColor mark color must be a hex color such as #ff5630, got "red"`,
+				"content[0].content[2].marks[1]: alignment mark not allowed on text",
+			},
+		},
+		{
+			name: "media single holds one media",
+			doc: responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{
+				{Type: "mediaSingle", Content: []responsetypes.NodeContent{
+					{Type: "media", Attrs: &responsetypes.NodeAttrs{Type: "file", ID: "f1"}},
+					{Type: "media", Attrs: &responsetypes.NodeAttrs{Type: "external"}},
+				}},
+			}},
+			want: []string{
+				"content[0]: mediaSingle allows at most 1 content node(s), got 2",
+				"content[0].content[1].attrs.url: external media requires a url",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.doc)
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Validate() error = %v, want ValidationErrors", err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	err := Validate(responsetypes.AtlassianDocumentFormat{Type: "doc", Version: 1, Content: []responsetypes.DocumentNode{{Type: "text", Text: "x"}}})
+
+	want := "invalid ADF document: content[0]: text not allowed in doc"
+	if err == nil || err.Error() != want {
+		t.Errorf("Error() = %v, want %q", err, want)
+	}
+
+	var problem *ValidationError
+	if !errors.As(err, &problem) || problem.Path != "content[0]" {
+		t.Errorf("errors.As() = %v, want the problem at content[0]", problem)
+	}
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -10,6 +11,9 @@ import (
 type BasicAuth struct {
 	Username string
 	Password string // can be either password or API token
+
+	// When set, the password is read from the provider on every request instead of Password
+	PasswordProvider CredentialProvider
 }
 
 // NewBasicAuth creates a new BasicAuth instance
@@ -20,18 +24,81 @@ func NewBasicAuth(username, password string) *BasicAuth {
 	}
 }
 
+// NewBasicAuthFromProvider creates a new BasicAuth instance reading the password from a provider,
+// so that it can be rotated without a restart
+func NewBasicAuthFromProvider(username string, provider CredentialProvider) *BasicAuth {
+	return &BasicAuth{
+		Username:         username,
+		PasswordProvider: provider,
+	}
+}
+
 // AddAuthentication adds the basic authentication headers to the request
 func (a *BasicAuth) AddAuthentication(req *http.Request) error {
-	if a.Username == "" || a.Password == "" {
+	password := a.Password
+	if a.PasswordProvider != nil {
+		var err error
+		if password, err = a.PasswordProvider.Credential(req.Context()); err != nil {
+			return fmt.Errorf("error reading password: %w", err)
+		}
+	}
+	if a.Username == "" || password == "" {
 		return fmt.Errorf("username and password cannot be empty")
 	}
 
-	auth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", a.Username, a.Password)))
+	auth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", a.Username, password)))
 	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", auth))
 	return nil
 }
 
+// TokenAuth represents bearer authentication using a personal access token (PAT),
+// as used by Jira Data Center and Server
+type TokenAuth struct {
+	Token string
+
+	// When set, the token is read from the provider on every request instead of Token
+	TokenProvider CredentialProvider
+}
+
+// NewTokenAuth creates a new TokenAuth instance
+func NewTokenAuth(token string) *TokenAuth {
+	return &TokenAuth{
+		Token: token,
+	}
+}
+
+// NewTokenAuthFromProvider creates a new TokenAuth instance reading the token from a provider,
+// so that it can be rotated without a restart
+func NewTokenAuthFromProvider(provider CredentialProvider) *TokenAuth {
+	return &TokenAuth{
+		TokenProvider: provider,
+	}
+}
+
+// AddAuthentication adds the bearer token header to the request
+func (a *TokenAuth) AddAuthentication(req *http.Request) error {
+	token := a.Token
+	if a.TokenProvider != nil {
+		var err error
+		if token, err = a.TokenProvider.Credential(req.Context()); err != nil {
+			return fmt.Errorf("error reading token: %w", err)
+		}
+	}
+	if token == "" {
+		return fmt.Errorf("token cannot be empty")
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
 // Authenticator interface defines the methods required for authentication
 type Authenticator interface {
 	AddAuthentication(req *http.Request) error
 }
+
+// BaseURLRewriter is implemented by authenticators whose requests must be sent to another
+// base URL than the Jira site, e.g. OAuth 2.0 apps calling Jira through api.atlassian.com
+type BaseURLRewriter interface {
+	RewriteBaseURL(ctx context.Context, baseURL string) (string, error)
+}
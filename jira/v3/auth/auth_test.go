@@ -73,3 +73,63 @@ func TestBasicAuth_AddAuthentication(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenAuth_AddAuthentication(t *testing.T) {
+	tests := []struct {
+		name     string
+		auth     *TokenAuth
+		wantAuth string
+		wantErr  bool
+	}{
+		{
+			name:     "Valid token",
+			auth:     NewTokenAuth("pat-123"),
+			wantAuth: "Bearer pat-123",
+		},
+		{
+			name:    "Empty token",
+			auth:    NewTokenAuth(""),
+			wantErr: true,
+		},
+		{
+			name:     "Token from provider",
+			auth:     NewTokenAuthFromProvider(StaticProvider("rotated-pat")),
+			wantAuth: "Bearer rotated-pat",
+		},
+		{
+			name:    "Provider without credential",
+			auth:    NewTokenAuthFromProvider(StaticProvider("")),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "http://example.com", nil)
+
+			err := tt.auth.AddAuthentication(req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TokenAuth.AddAuthentication() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got := req.Header.Get("Authorization"); got != tt.wantAuth {
+				t.Errorf("TokenAuth.AddAuthentication() = %v, want %v", got, tt.wantAuth)
+			}
+		})
+	}
+}
+
+func TestBasicAuth_PasswordProvider(t *testing.T) {
+	a := NewBasicAuthFromProvider("user@example.com", StaticProvider("api-token-123"))
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+
+	if err := a.AddAuthentication(req); err != nil {
+		t.Fatalf("BasicAuth.AddAuthentication() error = %v", err)
+	}
+
+	expectedAuth := fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte("user@example.com:api-token-123")))
+	if got := req.Header.Get("Authorization"); got != expectedAuth {
+		t.Errorf("BasicAuth.AddAuthentication() = %v, want %v", got, expectedAuth)
+	}
+}
@@ -0,0 +1,14 @@
+package auth
+
+const (
+	// OAuth 2.0 (3LO) endpoints of the Atlassian authorization server
+	OAUTH2_AUTHORIZE_URL            = "https://auth.atlassian.com/authorize"
+	OAUTH2_TOKEN_URL                = "https://auth.atlassian.com/oauth/token"
+	OAUTH2_ACCESSIBLE_RESOURCES_URL = "https://api.atlassian.com/oauth/token/accessible-resources"
+
+	// OAuth 2.0 apps call Jira through the API gateway, addressed by cloud ID
+	OAUTH2_API_BASE_URL = "https://api.atlassian.com/ex/jira/%s"
+
+	// OAuth 2.0 audience of the Atlassian APIs
+	OAUTH2_AUDIENCE = "api.atlassian.com"
+)
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCommandCredentialTTL is how long the output of a CommandProvider is reused when no TTL is set
+const DefaultCommandCredentialTTL = 5 * time.Minute
+
+// ErrNoCredential is returned by a CredentialProvider that has no credential to offer
+var ErrNoCredential = errors.New("no credential found")
+
+// CredentialProvider supplies a secret, such as an API token or a personal access token.
+// Providers are asked on every request, so rotated secrets are picked up without a restart.
+type CredentialProvider interface {
+	Credential(ctx context.Context) (string, error)
+}
+
+// CredentialProviderFunc adapts a function to the CredentialProvider interface
+type CredentialProviderFunc func(ctx context.Context) (string, error)
+
+// Credential calls f(ctx)
+func (f CredentialProviderFunc) Credential(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticProvider returns a fixed secret
+type StaticProvider string
+
+// Credential returns the secret
+func (p StaticProvider) Credential(ctx context.Context) (string, error) {
+	if p == "" {
+		return "", ErrNoCredential
+	}
+	return string(p), nil
+}
+
+// EnvProvider reads a secret from an environment variable
+type EnvProvider struct {
+	Name string
+}
+
+// NewEnvProvider creates a new EnvProvider reading the variable name
+func NewEnvProvider(name string) *EnvProvider {
+	return &EnvProvider{Name: name}
+}
+
+// Credential returns the value of the environment variable
+func (p *EnvProvider) Credential(ctx context.Context) (string, error) {
+	value := strings.TrimSpace(os.Getenv(p.Name))
+	if value == "" {
+		return "", fmt.Errorf("environment variable %s: %w", p.Name, ErrNoCredential)
+	}
+	return value, nil
+}
+
+// FileProvider reads a secret from a file, e.g. a mounted Kubernetes or Docker secret.
+// The file is read on every call and surrounding whitespace is trimmed.
+type FileProvider struct {
+	Path string
+}
+
+// NewFileProvider creates a new FileProvider reading path
+func NewFileProvider(path string) *FileProvider {
+	return &FileProvider{Path: path}
+}
+
+// Credential returns the content of the file
+func (p *FileProvider) Credential(ctx context.Context) (string, error) {
+	data, err := os.ReadFile(p.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("file %s: %w", p.Path, ErrNoCredential)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return "", fmt.Errorf("file %s is empty: %w", p.Path, ErrNoCredential)
+	}
+	return value, nil
+}
+
+// CommandProvider reads a secret from the standard output of an external command,
+// e.g. a password manager or vault CLI. The output is reused for TTL to avoid running
+// the command on every request.
+type CommandProvider struct {
+	Name string
+	Args []string
+
+	// How long the output is reused. Defaults to DefaultCommandCredentialTTL, negative disables caching
+	TTL time.Duration
+
+	mu        sync.Mutex
+	value     string
+	fetchedAt time.Time
+}
+
+// NewCommandProvider creates a new CommandProvider running name with args
+func NewCommandProvider(name string, args ...string) *CommandProvider {
+	return &CommandProvider{
+		Name: name,
+		Args: args,
+	}
+}
+
+// Credential returns the output of the command
+func (p *CommandProvider) Credential(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ttl := p.TTL
+	if ttl == 0 {
+		ttl = DefaultCommandCredentialTTL
+	}
+	if p.value != "" && ttl > 0 && time.Since(p.fetchedAt) < ttl {
+		return p.value, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Name, p.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("error running credential command %s: %w: %s", p.Name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	value := strings.TrimSpace(stdout.String())
+	if value == "" {
+		return "", fmt.Errorf("credential command %s printed nothing: %w", p.Name, ErrNoCredential)
+	}
+
+	p.value = value
+	p.fetchedAt = time.Now()
+	return value, nil
+}
+
+// ChainProvider asks each provider in order and returns the first credential found.
+// Providers reporting ErrNoCredential are skipped, any other error stops the chain.
+type ChainProvider struct {
+	Providers []CredentialProvider
+}
+
+// NewChainProvider creates a new ChainProvider
+func NewChainProvider(providers ...CredentialProvider) *ChainProvider {
+	return &ChainProvider{Providers: providers}
+}
+
+// Credential returns the first credential found
+func (p *ChainProvider) Credential(ctx context.Context) (string, error) {
+	for _, provider := range p.Providers {
+		value, err := provider.Credential(ctx)
+		if errors.Is(err, ErrNoCredential) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return value, nil
+	}
+	return "", ErrNoCredential
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("JIRA_TEST_TOKEN", " env-token\n")
+
+	got, err := NewEnvProvider("JIRA_TEST_TOKEN").Credential(context.Background())
+	if err != nil || got != "env-token" {
+		t.Errorf("EnvProvider.Credential() = %v, %v, want env-token", got, err)
+	}
+
+	_, err = NewEnvProvider("JIRA_TEST_TOKEN_MISSING").Credential(context.Background())
+	if !errors.Is(err, ErrNoCredential) {
+		t.Errorf("EnvProvider.Credential() error = %v, want ErrNoCredential", err)
+	}
+}
+
+func TestFileProvider_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	provider := NewFileProvider(path)
+	ctx := context.Background()
+
+	if _, err := provider.Credential(ctx); !errors.Is(err, ErrNoCredential) {
+		t.Errorf("FileProvider.Credential() error = %v, want ErrNoCredential for missing file", err)
+	}
+
+	os.WriteFile(path, []byte("token-v1\n"), 0o600)
+	if got, _ := provider.Credential(ctx); got != "token-v1" {
+		t.Errorf("FileProvider.Credential() = %v, want token-v1", got)
+	}
+
+	os.WriteFile(path, []byte("token-v2\n"), 0o600)
+	if got, _ := provider.Credential(ctx); got != "token-v2" {
+		t.Errorf("FileProvider.Credential() = %v, want rotated token-v2", got)
+	}
+}
+
+func TestCommandProvider(t *testing.T) {
+	provider := NewCommandProvider("echo", "command-token")
+
+	got, err := provider.Credential(context.Background())
+	if err != nil || got != "command-token" {
+		t.Errorf("CommandProvider.Credential() = %v, %v, want command-token", got, err)
+	}
+
+	failing := NewCommandProvider("false")
+	if _, err := failing.Credential(context.Background()); err == nil {
+		t.Error("CommandProvider.Credential() expected error for failing command, got nil")
+	}
+}
+
+func TestChainProvider(t *testing.T) {
+	ctx := context.Background()
+	boom := errors.New("vault unavailable")
+
+	tests := []struct {
+		name      string
+		providers []CredentialProvider
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "first provider with a credential wins",
+			providers: []CredentialProvider{NewEnvProvider("JIRA_TEST_UNSET"), StaticProvider("fallback"), StaticProvider("ignored")},
+			want:      "fallback",
+		},
+		{
+			name:      "no credential at all",
+			providers: []CredentialProvider{NewEnvProvider("JIRA_TEST_UNSET"), StaticProvider("")},
+			wantErr:   ErrNoCredential,
+		},
+		{
+			name: "other errors stop the chain",
+			providers: []CredentialProvider{
+				CredentialProviderFunc(func(ctx context.Context) (string, error) { return "", boom }),
+				StaticProvider("ignored"),
+			},
+			wantErr: boom,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewChainProvider(tt.providers...).Credential(ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ChainProvider.Credential() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ChainProvider.Credential() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryLeeway is how long before its expiry an access token is refreshed
+const tokenExpiryLeeway = time.Minute
+
+// OAuth2Config holds the settings of an OAuth 2.0 (3LO) app registered in the Atlassian developer console
+type OAuth2Config struct {
+	// The client ID of the app
+	ClientID string
+
+	// The client secret of the app
+	ClientSecret string
+
+	// The callback URL registered for the app
+	RedirectURL string
+
+	// The scopes to request, e.g. "read:jira-work", "write:jira-work", "offline_access"
+	Scopes []string
+
+	// The HTTP client used to call the authorization server. Defaults to http.DefaultClient
+	HTTPClient *http.Client
+
+	// Endpoint overrides, mostly useful for tests. Default to the Atlassian endpoints
+	AuthorizeURL           string
+	TokenURL               string
+	AccessibleResourcesURL string
+}
+
+// OAuth2Token is a token pair issued by the authorization server
+type OAuth2Token struct {
+	// The access token sent as bearer token
+	AccessToken string `json:"access_token"`
+
+	// The refresh token used to get a new access token. Requires the offline_access scope
+	RefreshToken string `json:"refresh_token,omitempty"`
+
+	// The type of the token, always "Bearer"
+	TokenType string `json:"token_type,omitempty"`
+
+	// The scopes granted to the token
+	Scope string `json:"scope,omitempty"`
+
+	// When the access token expires
+	Expiry time.Time `json:"expiry,omitempty"`
+
+	// The cloud ID of the Jira site the token is used with, once resolved
+	CloudID string `json:"cloud_id,omitempty"`
+}
+
+// Valid reports whether the access token is set and not about to expire
+func (t *OAuth2Token) Valid(now time.Time) bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || now.Add(tokenExpiryLeeway).Before(t.Expiry)
+}
+
+// AccessibleResource is a site the user granted the app access to
+type AccessibleResource struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes,omitempty"`
+	AvatarURL string   `json:"avatarUrl,omitempty"`
+}
+
+// tokenResponse is the payload returned by the token endpoint
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	Scope        string `json:"scope"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Error        string `json:"error"`
+	ErrorDesc    string `json:"error_description"`
+}
+
+func (c *OAuth2Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// AuthCodeURL returns the URL the user is sent to in order to grant access to the app.
+// state must be an unguessable value that is checked when the user comes back to RedirectURL.
+func (c *OAuth2Config) AuthCodeURL(state string) string {
+	endpoint := c.AuthorizeURL
+	if endpoint == "" {
+		endpoint = OAUTH2_AUTHORIZE_URL
+	}
+
+	params := url.Values{}
+	params.Set("audience", OAUTH2_AUDIENCE)
+	params.Set("client_id", c.ClientID)
+	params.Set("scope", strings.Join(c.Scopes, " "))
+	params.Set("redirect_uri", c.RedirectURL)
+	params.Set("state", state)
+	params.Set("response_type", "code")
+	params.Set("prompt", "consent")
+
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode())
+}
+
+// Exchange exchanges an authorization code for a token pair
+func (c *OAuth2Config) Exchange(ctx context.Context, code string) (*OAuth2Token, error) {
+	if code == "" {
+		return nil, fmt.Errorf("authorization code is required")
+	}
+	return c.requestToken(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.RedirectURL,
+	})
+}
+
+// Refresh gets a new token pair using a refresh token
+func (c *OAuth2Config) Refresh(ctx context.Context, refreshToken string) (*OAuth2Token, error) {
+	if refreshToken == "" {
+		return nil, fmt.Errorf("refresh token is required")
+	}
+	token, err := c.requestToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Refresh tokens rotate, but keep the old one if the server did not issue a new one
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// requestToken posts a grant to the token endpoint
+func (c *OAuth2Config) requestToken(ctx context.Context, grant map[string]string) (*OAuth2Token, error) {
+	endpoint := c.TokenURL
+	if endpoint == "" {
+		endpoint = OAUTH2_TOKEN_URL
+	}
+
+	body, err := json.Marshal(grant)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload tokenResponse
+	if err := json.Unmarshal(raw, &payload); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("error decoding token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || payload.AccessToken == "" {
+		if payload.Error != "" {
+			return nil, fmt.Errorf("token request failed: HTTP %d: %s: %s", resp.StatusCode, payload.Error, payload.ErrorDesc)
+		}
+		return nil, fmt.Errorf("token request failed: HTTP %d: %s", resp.StatusCode, string(raw))
+	}
+
+	token := &OAuth2Token{
+		AccessToken:  payload.AccessToken,
+		RefreshToken: payload.RefreshToken,
+		TokenType:    payload.TokenType,
+		Scope:        payload.Scope,
+	}
+	if payload.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// AccessibleResources lists the sites the access token grants access to
+func (c *OAuth2Config) AccessibleResources(ctx context.Context, accessToken string) ([]AccessibleResource, error) {
+	endpoint := c.AccessibleResourcesURL
+	if endpoint == "" {
+		endpoint = OAUTH2_ACCESSIBLE_RESOURCES_URL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("accessible resources request failed: HTTP %d: %s", resp.StatusCode, string(body))
+	}
+
+	var resources []AccessibleResource
+	if err := json.NewDecoder(resp.Body).Decode(&resources); err != nil {
+		return nil, fmt.Errorf("error decoding accessible resources: %w", err)
+	}
+	return resources, nil
+}
+
+// OAuth2Auth authenticates requests on behalf of a user with an OAuth 2.0 (3LO) access token.
+// Expired access tokens are refreshed transparently, and tokens are persisted through a TokenStore.
+// It is safe for concurrent use.
+type OAuth2Auth struct {
+	config *OAuth2Config
+	store  TokenStore
+
+	mu     sync.Mutex
+	token  *OAuth2Token
+	loaded bool
+	now    func() time.Time
+}
+
+// NewOAuth2Auth creates a new OAuth2Auth instance.
+// A nil store keeps the token in memory only.
+func NewOAuth2Auth(config *OAuth2Config, store TokenStore) *OAuth2Auth {
+	if store == nil {
+		store = NewMemoryTokenStore(nil)
+	}
+	return &OAuth2Auth{
+		config: config,
+		store:  store,
+		now:    time.Now,
+	}
+}
+
+// AuthCodeURL returns the URL the user is sent to in order to grant access to the app
+func (a *OAuth2Auth) AuthCodeURL(state string) string {
+	return a.config.AuthCodeURL(state)
+}
+
+// Exchange exchanges an authorization code for a token pair and saves it to the store
+func (a *OAuth2Auth) Exchange(ctx context.Context, code string) (*OAuth2Token, error) {
+	token, err := a.config.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.setToken(ctx, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// ResolveCloudID finds the cloud ID of the Jira site at siteURL among the sites the user granted access to,
+// and remembers it so that requests are sent through the API gateway.
+// An empty siteURL picks the first accessible site.
+func (a *OAuth2Auth) ResolveCloudID(ctx context.Context, siteURL string) (string, error) {
+	token, err := a.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	resources, err := a.config.AccessibleResources(ctx, token.AccessToken)
+	if err != nil {
+		return "", err
+	}
+
+	want := strings.TrimRight(strings.ToLower(siteURL), "/")
+	var cloudID string
+	for _, resource := range resources {
+		if want == "" || strings.TrimRight(strings.ToLower(resource.URL), "/") == want {
+			cloudID = resource.ID
+			break
+		}
+	}
+	if cloudID == "" {
+		return "", fmt.Errorf("no accessible resource found for site %q", siteURL)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	updated := *a.token
+	updated.CloudID = cloudID
+	if err := a.setToken(ctx, &updated); err != nil {
+		return "", err
+	}
+	return cloudID, nil
+}
+
+// Token returns a valid access token, refreshing it when it is about to expire
+func (a *OAuth2Auth) Token(ctx context.Context) (*OAuth2Token, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.load(ctx); err != nil {
+		return nil, err
+	}
+	if a.token == nil {
+		return nil, fmt.Errorf("no OAuth 2.0 token available, complete the authorization flow first")
+	}
+	if a.token.Valid(a.now()) {
+		return a.token, nil
+	}
+
+	refreshed, err := a.config.Refresh(ctx, a.token.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing access token: %w", err)
+	}
+	refreshed.CloudID = a.token.CloudID
+	if err := a.setToken(ctx, refreshed); err != nil {
+		return nil, err
+	}
+	return a.token, nil
+}
+
+// AddAuthentication adds the bearer access token to the request
+func (a *OAuth2Auth) AddAuthentication(req *http.Request) error {
+	token, err := a.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token.AccessToken))
+	return nil
+}
+
+// RewriteBaseURL sends requests through api.atlassian.com once the cloud ID is known
+func (a *OAuth2Auth) RewriteBaseURL(ctx context.Context, baseURL string) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.load(ctx); err != nil {
+		return "", err
+	}
+	if a.token == nil || a.token.CloudID == "" {
+		return baseURL, nil
+	}
+	return fmt.Sprintf(OAUTH2_API_BASE_URL, a.token.CloudID), nil
+}
+
+// load reads the token from the store once. The caller must hold a.mu.
+func (a *OAuth2Auth) load(ctx context.Context) error {
+	if a.loaded {
+		return nil
+	}
+	token, err := a.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("error loading token: %w", err)
+	}
+	a.token = token
+	a.loaded = true
+	return nil
+}
+
+// setToken saves the token to the store and keeps it in memory. The caller must hold a.mu.
+func (a *OAuth2Auth) setToken(ctx context.Context, token *OAuth2Token) error {
+	if err := a.store.Save(ctx, token); err != nil {
+		return fmt.Errorf("error saving token: %w", err)
+	}
+	a.token = token
+	a.loaded = true
+	return nil
+}
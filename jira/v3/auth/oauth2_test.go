@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newOAuth2Server mocks the token and accessible-resources endpoints
+func newOAuth2Server(t *testing.T, refreshes *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/token":
+			var grant map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&grant); err != nil {
+				t.Errorf("Failed to decode grant: %v", err)
+			}
+			if grant["client_id"] != "client-id" || grant["client_secret"] != "client-secret" {
+				t.Errorf("Unexpected client credentials: %v", grant)
+			}
+
+			switch grant["grant_type"] {
+			case "authorization_code":
+				if grant["code"] != "auth-code" {
+					w.WriteHeader(http.StatusForbidden)
+					json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant", "error_description": "Invalid authorization code"})
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"access_token":  "access-1",
+					"refresh_token": "refresh-1",
+					"token_type":    "Bearer",
+					"expires_in":    3600,
+				})
+			case "refresh_token":
+				n := refreshes.Add(1)
+				if grant["refresh_token"] != "refresh-1" {
+					t.Errorf("refresh_token = %v, want refresh-1", grant["refresh_token"])
+				}
+				// Simulate a slow token endpoint to surface concurrent refreshes
+				time.Sleep(20 * time.Millisecond)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"access_token":  "access-refreshed",
+					"refresh_token": "refresh-2",
+					"expires_in":    3600 * int(n),
+				})
+			}
+		case "/oauth/token/accessible-resources":
+			if r.Header.Get("Authorization") != "Bearer access-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode([]AccessibleResource{
+				{ID: "cloud-other", URL: "https://other.atlassian.net", Name: "other"},
+				{ID: "cloud-123", URL: "https://example.atlassian.net", Name: "example"},
+			})
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+}
+
+func newTestOAuth2Config(serverURL string) *OAuth2Config {
+	return &OAuth2Config{
+		ClientID:               "client-id",
+		ClientSecret:           "client-secret",
+		RedirectURL:            "https://app.example.com/callback",
+		Scopes:                 []string{"read:jira-work", "offline_access"},
+		TokenURL:               serverURL + "/oauth/token",
+		AccessibleResourcesURL: serverURL + "/oauth/token/accessible-resources",
+	}
+}
+
+func TestOAuth2Config_AuthCodeURL(t *testing.T) {
+	config := newTestOAuth2Config("")
+	got, err := url.Parse(config.AuthCodeURL("state-xyz"))
+	if err != nil {
+		t.Fatalf("AuthCodeURL() is not a valid URL: %v", err)
+	}
+
+	if got.Scheme+"://"+got.Host+got.Path != OAUTH2_AUTHORIZE_URL {
+		t.Errorf("AuthCodeURL() endpoint = %v, want %v", got.Host+got.Path, OAUTH2_AUTHORIZE_URL)
+	}
+	want := map[string]string{
+		"audience":      "api.atlassian.com",
+		"client_id":     "client-id",
+		"scope":         "read:jira-work offline_access",
+		"redirect_uri":  "https://app.example.com/callback",
+		"state":         "state-xyz",
+		"response_type": "code",
+		"prompt":        "consent",
+	}
+	for k, v := range want {
+		if got.Query().Get(k) != v {
+			t.Errorf("AuthCodeURL() %s = %v, want %v", k, got.Query().Get(k), v)
+		}
+	}
+}
+
+func TestOAuth2Auth_Flow(t *testing.T) {
+	var refreshes atomic.Int32
+	server := newOAuth2Server(t, &refreshes)
+	defer server.Close()
+
+	store := NewFileTokenStore(filepath.Join(t.TempDir(), "token.json"))
+	a := NewOAuth2Auth(newTestOAuth2Config(server.URL), store)
+	ctx := context.Background()
+
+	if _, err := a.Exchange(ctx, "wrong-code"); err == nil {
+		t.Error("Exchange() expected error for invalid code, got nil")
+	}
+
+	token, err := a.Exchange(ctx, "auth-code")
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" {
+		t.Errorf("Exchange() token = %+v", token)
+	}
+
+	// No cloud ID yet, the site URL is kept
+	base, err := a.RewriteBaseURL(ctx, "https://example.atlassian.net")
+	if err != nil || base != "https://example.atlassian.net" {
+		t.Errorf("RewriteBaseURL() = %v, %v, want site URL", base, err)
+	}
+
+	cloudID, err := a.ResolveCloudID(ctx, "https://example.atlassian.net/")
+	if err != nil {
+		t.Fatalf("ResolveCloudID() error = %v", err)
+	}
+	if cloudID != "cloud-123" {
+		t.Errorf("ResolveCloudID() = %v, want cloud-123", cloudID)
+	}
+	if _, err := a.ResolveCloudID(ctx, "https://unknown.atlassian.net"); err == nil {
+		t.Error("ResolveCloudID() expected error for unknown site, got nil")
+	}
+
+	// A new authenticator on the same store picks up the persisted token and cloud ID
+	restored := NewOAuth2Auth(newTestOAuth2Config(server.URL), store)
+	base, err = restored.RewriteBaseURL(ctx, "https://example.atlassian.net")
+	if err != nil {
+		t.Fatalf("RewriteBaseURL() error = %v", err)
+	}
+	if base != "https://api.atlassian.com/ex/jira/cloud-123" {
+		t.Errorf("RewriteBaseURL() = %v, want API gateway URL", base)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err := restored.AddAuthentication(req); err != nil {
+		t.Fatalf("AddAuthentication() error = %v", err)
+	}
+	if req.Header.Get("Authorization") != "Bearer access-1" {
+		t.Errorf("Authorization = %v, want Bearer access-1", req.Header.Get("Authorization"))
+	}
+	if refreshes.Load() != 0 {
+		t.Errorf("refreshes = %v, want 0 for a valid token", refreshes.Load())
+	}
+}
+
+func TestOAuth2Auth_ConcurrentRefresh(t *testing.T) {
+	var refreshes atomic.Int32
+	server := newOAuth2Server(t, &refreshes)
+	defer server.Close()
+
+	store := NewMemoryTokenStore(&OAuth2Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh-1",
+		Expiry:       time.Now().Add(-time.Hour),
+		CloudID:      "cloud-123",
+	})
+	a := NewOAuth2Auth(newTestOAuth2Config(server.URL), store)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+			if err := a.AddAuthentication(req); err != nil {
+				t.Errorf("AddAuthentication() error = %v", err)
+				return
+			}
+			if req.Header.Get("Authorization") != "Bearer access-refreshed" {
+				t.Errorf("Authorization = %v, want Bearer access-refreshed", req.Header.Get("Authorization"))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if refreshes.Load() != 1 {
+		t.Errorf("refreshes = %v, want exactly 1", refreshes.Load())
+	}
+
+	saved, _ := store.Load(context.Background())
+	if saved.AccessToken != "access-refreshed" || saved.RefreshToken != "refresh-2" {
+		t.Errorf("saved token = %+v, want rotated tokens", saved)
+	}
+	if saved.CloudID != "cloud-123" {
+		t.Errorf("saved CloudID = %v, want cloud-123 kept across refresh", saved.CloudID)
+	}
+}
+
+func TestOAuth2Auth_NoToken(t *testing.T) {
+	a := NewOAuth2Auth(newTestOAuth2Config("http://127.0.0.1:0"), nil)
+	req, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err := a.AddAuthentication(req); err == nil {
+		t.Error("AddAuthentication() expected error without token, got nil")
+	}
+}
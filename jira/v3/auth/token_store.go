@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// TokenStore persists OAuth 2.0 tokens, e.g. across restarts or per user of a multi-user app
+type TokenStore interface {
+	// Load returns the stored token, or nil without error when no token is stored yet
+	Load(ctx context.Context) (*OAuth2Token, error)
+
+	// Save stores the token, replacing any previous one
+	Save(ctx context.Context, token *OAuth2Token) error
+}
+
+// MemoryTokenStore keeps a token in memory
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *OAuth2Token
+}
+
+// NewMemoryTokenStore creates a new MemoryTokenStore holding an optional initial token
+func NewMemoryTokenStore(token *OAuth2Token) *MemoryTokenStore {
+	return &MemoryTokenStore{token: token}
+}
+
+// Load returns the token kept in memory
+func (s *MemoryTokenStore) Load(ctx context.Context) (*OAuth2Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil {
+		return nil, nil
+	}
+	token := *s.token
+	return &token, nil
+}
+
+// Save keeps a copy of the token in memory
+func (s *MemoryTokenStore) Save(ctx context.Context, token *OAuth2Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if token == nil {
+		s.token = nil
+		return nil
+	}
+	copied := *token
+	s.token = &copied
+	return nil
+}
+
+// FileTokenStore keeps a token as JSON in a file readable by the current user only
+type FileTokenStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileTokenStore creates a new FileTokenStore writing to path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{Path: path}
+}
+
+// Load reads the token from the file
+func (s *FileTokenStore) Load(ctx context.Context) (*OAuth2Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := new(OAuth2Token)
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("error decoding token file %s: %w", s.Path, err)
+	}
+	return token, nil
+}
+
+// Save writes the token to the file atomically
+func (s *FileTokenStore) Save(ctx context.Context, token *OAuth2Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
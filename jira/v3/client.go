@@ -0,0 +1,148 @@
+// Package jira provides a single entry point to the Jira Cloud REST API v3.
+// A Client owns the shared request pipeline and exposes one handle per API group.
+package jira
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/issue"
+	"github.com/ducminhgd/go-atlassian/jira/v3/project"
+	"github.com/ducminhgd/go-atlassian/jira/v3/rest"
+)
+
+// Client is a Jira REST API client.
+// Every service shares the same HTTP client, authenticator, base URL and error handling.
+type Client struct {
+	rest *rest.Client
+
+	// Issue provides access to the Issue API group
+	Issue *issue.Service
+
+	// Project provides access to the Project API group
+	Project *project.Service
+}
+
+// clientConfig holds the settings collected from ClientOption values
+type clientConfig struct {
+	httpClient    *http.Client
+	authenticator auth.Authenticator
+	timeout       time.Duration
+	userAgent     string
+	retry         *rest.RetryPolicy
+	metadataTTL   *time.Duration
+	validateADF   bool
+}
+
+// ClientOption configures a Client
+type ClientOption func(*clientConfig)
+
+// WithHTTPClient sets the HTTP client used to send requests
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *clientConfig) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithAuthenticator sets the authenticator used to sign every request
+func WithAuthenticator(authenticator auth.Authenticator) ClientOption {
+	return func(c *clientConfig) {
+		c.authenticator = authenticator
+	}
+}
+
+// WithTimeout sets the timeout applied to every request
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *clientConfig) {
+		c.userAgent = userAgent
+	}
+}
+
+// RetryPolicy configures the retry layer enabled with WithRetry
+type RetryPolicy = rest.RetryPolicy
+
+// WithRetry enables retries of throttled (429) and unavailable (503) responses.
+// Only idempotent methods are retried unless the policy says otherwise.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *clientConfig) {
+		c.retry = &policy
+	}
+}
+
+// WithMetadataCacheTTL sets how long issue types, priorities, statuses and fields are cached.
+// A zero or negative ttl disables caching.
+func WithMetadataCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.metadataTTL = &ttl
+	}
+}
+
+// WithADFValidation checks the ADF documents of issue write requests, such as descriptions and comment bodies,
+// with adf.Validate before they are sent
+func WithADFValidation() ClientOption {
+	return func(c *clientConfig) {
+		c.validateADF = true
+	}
+}
+
+// NewClient creates a new Jira client for the site at baseURL,
+// e.g. "https://your-domain.atlassian.net"
+func NewClient(baseURL string, opts ...ClientOption) (*Client, error) {
+	normalized, err := rest.NormalizeBaseURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &clientConfig{
+		userAgent: rest.DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	// Copy the HTTP client so that the timeout does not leak into the caller's client
+	httpClient := &http.Client{}
+	if cfg.httpClient != nil {
+		c := *cfg.httpClient
+		httpClient = &c
+	}
+	if cfg.timeout > 0 {
+		httpClient.Timeout = cfg.timeout
+	}
+	if cfg.retry != nil {
+		httpClient.Transport = rest.NewRetryTransport(httpClient.Transport, *cfg.retry)
+	}
+
+	restClient := rest.NewClient(httpClient, normalized, cfg.authenticator)
+	restClient.SetUserAgent(cfg.userAgent)
+
+	issueService := issue.NewServiceWithClient(restClient)
+	if cfg.metadataTTL != nil {
+		issueService.SetMetadataCacheTTL(*cfg.metadataTTL)
+	}
+	issueService.SetADFValidation(cfg.validateADF)
+
+	return &Client{
+		rest:    restClient,
+		Issue:   issueService,
+		Project: project.NewServiceWithClient(restClient),
+	}, nil
+}
+
+// BaseURL returns the normalized base URL of the Jira site
+func (c *Client) BaseURL() string {
+	return c.rest.BaseURL()
+}
+
+// Rest returns the shared request pipeline, for calling endpoints that have no dedicated service yet
+func (c *Client) Rest() *rest.Client {
+	return c.rest
+}
@@ -0,0 +1,148 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/adf"
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/issue"
+	"github.com/ducminhgd/go-atlassian/jira/v3/project"
+)
+
+func TestNewClient(t *testing.T) {
+	client, err := NewClient("example.atlassian.net/", WithAuthenticator(auth.NewBasicAuth("user", "token")))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if client.BaseURL() != "https://example.atlassian.net" {
+		t.Errorf("BaseURL() = %v, want https://example.atlassian.net", client.BaseURL())
+	}
+	if client.Issue == nil {
+		t.Error("Issue service is nil")
+	}
+	if client.Project == nil {
+		t.Error("Project service is nil")
+	}
+}
+
+func TestNewClient_InvalidBaseURL(t *testing.T) {
+	if _, err := NewClient(""); err == nil {
+		t.Error("Expected error for empty base URL, got nil")
+	}
+}
+
+func TestNewClient_TimeoutDoesNotLeak(t *testing.T) {
+	httpClient := &http.Client{}
+	client, err := NewClient("https://example.atlassian.net", WithHTTPClient(httpClient), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if client.Rest().HTTPClient().Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want 5s", client.Rest().HTTPClient().Timeout)
+	}
+	if httpClient.Timeout != 0 {
+		t.Errorf("Caller's HTTP client timeout = %v, want unchanged", httpClient.Timeout)
+	}
+}
+
+func TestClient_SharedPipeline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "report-bot/1.0" {
+			t.Errorf("User-Agent = %v, want report-bot/1.0", r.Header.Get("User-Agent"))
+		}
+		if r.Header.Get("Authorization") == "" {
+			t.Error("Missing Authorization header")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/issue/TEST-1":
+			json.NewEncoder(w).Encode(map[string]string{"key": "TEST-1"})
+		case "/rest/api/3/project/TEST":
+			json.NewEncoder(w).Encode(map[string]string{"key": "TEST"})
+		default:
+			// Redirects that are not followed are errors for every service
+			w.WriteHeader(http.StatusNotModified)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL,
+		WithAuthenticator(auth.NewBasicAuth("user", "token")),
+		WithUserAgent("report-bot/1.0"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := client.Issue.Get(ctx, "TEST-1", nil, nil, nil); err != nil {
+		t.Errorf("Issue.Get() error = %v", err)
+	}
+	if _, err := client.Project.Get(ctx, "TEST", project.ProjectQueryOpts{}); err != nil {
+		t.Errorf("Project.Get() error = %v", err)
+	}
+
+	if _, err := client.Issue.Get(ctx, "TEST-2", nil, nil, nil); err == nil {
+		t.Error("Issue.Get() expected error for 304 response, got nil")
+	}
+	if _, err := client.Project.Get(ctx, "OTHER", project.ProjectQueryOpts{}); err == nil {
+		t.Error("Project.Get() expected error for 304 response, got nil")
+	}
+}
+
+func TestNewClient_MetadataCacheTTL(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"2","name":"High"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithMetadataCacheTTL(0))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Issue.PriorityID(context.Background(), "High"); err != nil {
+			t.Fatalf("PriorityID() error = %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2 with caching disabled", requests)
+	}
+}
+
+func TestNewClient_ADFValidation(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10001"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, WithADFValidation())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	body := map[string]interface{}{"type": "doc", "version": 1, "content": []interface{}{map[string]interface{}{"type": "text", "text": "x"}}}
+	var invalid adf.ValidationErrors
+	if _, err := client.Issue.AddComment(context.Background(), "PROJ-1", issue.CommentRequest{Body: body}, nil); !errors.As(err, &invalid) {
+		t.Errorf("AddComment() error = %v, want adf.ValidationErrors", err)
+	}
+	if requests != 0 {
+		t.Errorf("requests = %d, want none for an invalid document", requests)
+	}
+}
@@ -0,0 +1,28 @@
+package jira
+
+import "github.com/ducminhgd/go-atlassian/jira/v3/rest"
+
+// APIError is returned by every service when Jira responds with a status code outside of the 2xx range.
+// Use errors.As to inspect it, or one of the Is* helpers below.
+type APIError = rest.APIError
+
+// IsBadRequest reports whether err is caused by an invalid request (400)
+func IsBadRequest(err error) bool { return rest.IsBadRequest(err) }
+
+// IsUnauthorized reports whether err is caused by missing or expired credentials (401)
+func IsUnauthorized(err error) bool { return rest.IsUnauthorized(err) }
+
+// IsForbidden reports whether err is caused by missing permissions (403)
+func IsForbidden(err error) bool { return rest.IsForbidden(err) }
+
+// IsNotFound reports whether err is caused by a resource that does not exist or is not visible (404)
+func IsNotFound(err error) bool { return rest.IsNotFound(err) }
+
+// IsConflict reports whether err is caused by a conflicting resource state (409)
+func IsConflict(err error) bool { return rest.IsConflict(err) }
+
+// IsRateLimited reports whether err is caused by Jira throttling the client (429)
+func IsRateLimited(err error) bool { return rest.IsRateLimited(err) }
+
+// IsServerError reports whether err is caused by a server side failure (5xx)
+func IsServerError(err error) bool { return rest.IsServerError(err) }
@@ -0,0 +1,74 @@
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/adf"
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// SetADFValidation turns on checking the ADF documents of write requests with adf.Validate before they are sent,
+// e.g. descriptions, comment bodies and worklog comments. Invalid documents fail with adf.ValidationErrors
+// instead of a bare 400 from Jira.
+func (s *Service) SetADFValidation(enabled bool) {
+	s.validateADF.Store(enabled)
+}
+
+// checkADF validates every ADF document in a request payload when ADF validation is turned on
+func (s *Service) checkADF(payload interface{}) error {
+	if !s.validateADF.Load() {
+		return nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error encoding request: %w", err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("error decoding request: %w", err)
+	}
+	return validateDocuments("", decoded)
+}
+
+// validateDocuments walks a decoded payload and validates the objects that are ADF documents, prefixing errors with their path
+func validateDocuments(path string, v interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if v["type"] == responsetypes.NodeTypeDoc {
+			doc, err := adf.Parse(v)
+			if err == nil {
+				err = adf.Validate(doc)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			return nil
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			if err := validateDocuments(keyPath, v[key]); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if err := validateDocuments(path+"["+strconv.Itoa(i)+"]", item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/adf"
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+func TestService_ADFValidation(t *testing.T) {
+	invalid := map[string]interface{}{
+		"type":    "doc",
+		"version": 1,
+		"content": []interface{}{map[string]interface{}{"type": "bulletList", "content": []interface{}{map[string]interface{}{"type": "text", "text": "x"}}}},
+	}
+	valid := adf.Doc().Text("Deployed")
+
+	// Jira stores a resized image with a fractional width
+	var resized interface{}
+	if err := json.Unmarshal([]byte(`{"type":"doc","version":1,"content":[
+		{"type":"mediaSingle","attrs":{"layout":"center","width":66.67,"widthType":"percentage"},"content":[
+			{"type":"media","attrs":{"type":"file","id":"abc-123","collection":"","width":1024.5,"height":768}}]}]}`), &resized); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		call    func(s *Service) error
+		wantErr string
+	}{
+		{
+			name: "create",
+			call: func(s *Service) error {
+				_, err := s.Create(context.Background(), IssueCreateRequest{Fields: map[string]interface{}{"summary": "x", "description": invalid}}, IssueCreateOpts{})
+				return err
+			},
+			wantErr: "fields.description: invalid ADF document: content[0].content[0]: text not allowed in bulletList",
+		},
+		{
+			name: "update operation",
+			call: func(s *Service) error {
+				return s.Update(context.Background(), "PROJ-1", IssueUpdateRequest{
+					Update: map[string][]FieldOperation{"comment": {{Add: map[string]interface{}{"body": invalid}}}},
+				}, IssueUpdateOpts{})
+			},
+			wantErr: "update.comment[0].add.body: invalid ADF document",
+		},
+		{
+			name: "transition comment",
+			call: func(s *Service) error {
+				return s.DoTransition(context.Background(), "PROJ-1", TransitionRequest{Transition: TransitionReference{ID: "31"}, Comment: invalid})
+			},
+			wantErr: "update.comment[0].add.body: invalid ADF document",
+		},
+		{
+			name: "add comment",
+			call: func(s *Service) error {
+				_, err := s.AddComment(context.Background(), "PROJ-1", CommentRequest{Body: invalid}, nil)
+				return err
+			},
+			wantErr: "body: invalid ADF document",
+		},
+		{
+			name: "update comment",
+			call: func(s *Service) error {
+				_, err := s.UpdateComment(context.Background(), "PROJ-1", "10001", CommentRequest{Body: invalid}, IssueCommentUpdateOpts{})
+				return err
+			},
+			wantErr: "body: invalid ADF document",
+		},
+		{
+			name: "add worklog",
+			call: func(s *Service) error {
+				_, err := s.AddWorklog(context.Background(), "PROJ-1", WorklogRequest{TimeSpent: "1h", Comment: invalid}, IssueWorklogWriteOpts{})
+				return err
+			},
+			wantErr: "comment: invalid ADF document",
+		},
+		{
+			name: "update worklog",
+			call: func(s *Service) error {
+				_, err := s.UpdateWorklog(context.Background(), "PROJ-1", "10001", WorklogRequest{Comment: invalid}, IssueWorklogWriteOpts{})
+				return err
+			},
+			wantErr: "comment: invalid ADF document",
+		},
+		{
+			name: "valid document is sent",
+			call: func(s *Service) error {
+				_, err := s.AddComment(context.Background(), "PROJ-1", CommentRequest{Body: valid}, nil)
+				return err
+			},
+		},
+		{
+			name: "resized image is sent",
+			call: func(s *Service) error {
+				_, err := s.AddComment(context.Background(), "PROJ-1", CommentRequest{Body: resized}, nil)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			service.SetADFValidation(true)
+
+			err := tt.call(service)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if requests != 1 {
+					t.Errorf("requests = %d, want 1", requests)
+				}
+				return
+			}
+
+			var problems adf.ValidationErrors
+			if !errors.As(err, &problems) || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+			if requests != 0 {
+				t.Errorf("requests = %d, want none for an invalid document", requests)
+			}
+
+			// Without validation the document is sent as is
+			service.SetADFValidation(false)
+			if err := tt.call(service); err != nil {
+				t.Errorf("error without validation = %v", err)
+			}
+			if requests != 1 {
+				t.Errorf("requests = %d, want 1 without validation", requests)
+			}
+		})
+	}
+}
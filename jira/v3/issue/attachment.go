@@ -0,0 +1,159 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AddAttachment uploads the content of r as an attachment named filename.
+// The multipart body is streamed, so large files are never held in memory.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-attachments/#api-rest-api-3-issue-issueidorkey-attachments-post
+func (s *Service) AddAttachment(ctx context.Context, issueIDOrKey, filename string, r io.Reader) ([]Attachment, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+	if filename == "" {
+		return nil, fmt.Errorf("filename is required")
+	}
+	if r == nil {
+		return nil, fmt.Errorf("attachment content is required")
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	// The transport closes the request body when it is done with it,
+	// which unblocks the writer if the request fails before the upload completes
+	go func() {
+		part, err := mw.CreateFormFile("file", filename)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	path := fmt.Sprintf(ISSUE_ATTACHMENTS_ENDPOINT, issueIDOrKey)
+	req, err := s.client.NewRawRequest(ctx, http.MethodPost, path, pr)
+	if err != nil {
+		pr.CloseWithError(err)
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	var attachments []Attachment
+	if err := s.client.Do(req, &attachments); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// GetAttachment retrieves the metadata of an attachment
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-attachments/#api-rest-api-3-attachment-id-get
+func (s *Service) GetAttachment(ctx context.Context, attachmentID string) (*Attachment, error) {
+	if attachmentID == "" {
+		return nil, fmt.Errorf("attachment ID is required")
+	}
+
+	path := fmt.Sprintf(ATTACHMENT_ENDPOINT, attachmentID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	attachment := new(Attachment)
+	if err := s.client.Do(req, attachment); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// DownloadAttachment streams the content of an attachment to w and returns the number of bytes written
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-attachments/#api-rest-api-3-attachment-content-id-get
+func (s *Service) DownloadAttachment(ctx context.Context, attachmentID string, w io.Writer) (int64, error) {
+	if attachmentID == "" {
+		return 0, fmt.Errorf("attachment ID is required")
+	}
+
+	return s.download(ctx, fmt.Sprintf(ATTACHMENT_CONTENT_ENDPOINT, attachmentID), w)
+}
+
+// DownloadAttachmentThumbnail streams the thumbnail of an image attachment to w and returns the number of bytes written
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-attachments/#api-rest-api-3-attachment-thumbnail-id-get
+func (s *Service) DownloadAttachmentThumbnail(ctx context.Context, attachmentID string, opts AttachmentThumbnailOpts, w io.Writer) (int64, error) {
+	if attachmentID == "" {
+		return 0, fmt.Errorf("attachment ID is required")
+	}
+
+	path := fmt.Sprintf(ATTACHMENT_THUMBNAIL_ENDPOINT, attachmentID)
+	params := url.Values{}
+
+	if opts.Width > 0 {
+		params.Add("width", strconv.Itoa(opts.Width))
+	}
+	if opts.Height > 0 {
+		params.Add("height", strconv.Itoa(opts.Height))
+	}
+	if opts.FallbackToDefault {
+		params.Add("fallbackToDefault", "true")
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	return s.download(ctx, path, w)
+}
+
+// DeleteAttachment deletes an attachment
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-attachments/#api-rest-api-3-attachment-id-delete
+func (s *Service) DeleteAttachment(ctx context.Context, attachmentID string) error {
+	if attachmentID == "" {
+		return fmt.Errorf("attachment ID is required")
+	}
+
+	path := fmt.Sprintf(ATTACHMENT_ENDPOINT, attachmentID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// download copies the body of a binary response to w.
+// Jira answers with a redirect to the media service, which the HTTP client follows.
+func (s *Service) download(ctx context.Context, path string, w io.Writer) (int64, error) {
+	req, err := s.client.NewRawRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := s.client.DoRaw(req)
+	if err != nil {
+		return 0, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("error reading response: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,204 @@
+package issue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+// repeatReader yields an endless stream of the same byte
+type repeatReader byte
+
+func (r repeatReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r)
+	}
+	return len(p), nil
+}
+
+// failingReader fails after the first read
+type failingReader struct{ read bool }
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.read {
+		return 0, errors.New("disk error")
+	}
+	r.read = true
+	return copy(p, "partial"), nil
+}
+
+func TestService_AddAttachment(t *testing.T) {
+	const size = 8 << 20
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %v, want POST", r.Method)
+		}
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/attachments" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		if r.Header.Get("X-Atlassian-Token") != "no-check" {
+			t.Errorf("X-Atlassian-Token = %v, want no-check", r.Header.Get("X-Atlassian-Token"))
+		}
+		if r.ContentLength != -1 {
+			t.Errorf("ContentLength = %v, want -1 for a streamed body", r.ContentLength)
+		}
+
+		mr, err := r.MultipartReader()
+		if err != nil {
+			t.Fatalf("MultipartReader() error = %v", err)
+		}
+		part, err := mr.NextPart()
+		if err != nil {
+			t.Fatalf("NextPart() error = %v", err)
+		}
+		if part.FormName() != "file" || part.FileName() != "build.log" {
+			t.Errorf("part = %v/%v, want file/build.log", part.FormName(), part.FileName())
+		}
+		n, _ := io.Copy(io.Discard, part)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Attachment{{ID: "10000", Filename: part.FileName(), Size: n}})
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	attachments, err := service.AddAttachment(context.Background(), "TEST-1", "build.log", io.LimitReader(repeatReader('x'), size))
+	if err != nil {
+		t.Fatalf("AddAttachment() error = %v", err)
+	}
+	if len(attachments) != 1 || attachments[0].Size != size {
+		t.Errorf("AddAttachment() = %+v, want one attachment of %d bytes", attachments, size)
+	}
+}
+
+func TestService_AddAttachment_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/3/issue/TEST-403/attachments" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	if _, err := service.AddAttachment(ctx, "TEST-403", "a.txt", strings.NewReader("data")); err == nil {
+		t.Error("AddAttachment() expected error for forbidden upload, got nil")
+	}
+	if _, err := service.AddAttachment(ctx, "TEST-1", "a.txt", &failingReader{}); err == nil {
+		t.Error("AddAttachment() expected error for failing reader, got nil")
+	}
+	if _, err := service.AddAttachment(ctx, "TEST-1", "", strings.NewReader("data")); err == nil {
+		t.Error("AddAttachment() expected error for missing filename, got nil")
+	}
+	if _, err := service.AddAttachment(ctx, "", "a.txt", strings.NewReader("data")); err == nil {
+		t.Error("AddAttachment() expected error for missing key, got nil")
+	}
+}
+
+func TestService_GetAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/attachment/10000" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Attachment{ID: "10000", Filename: "screenshot.png", MimeType: "image/png", Size: 2048})
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	got, err := service.GetAttachment(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("GetAttachment() error = %v", err)
+	}
+	if got.Filename != "screenshot.png" || got.Size != 2048 {
+		t.Errorf("GetAttachment() = %+v", got)
+	}
+}
+
+func TestService_DownloadAttachment(t *testing.T) {
+	content := []byte("\x89PNG binary content")
+
+	// Jira redirects content requests to the media service
+	media := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(content)
+	}))
+	defer media.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "*/*" {
+			t.Errorf("Accept = %v, want */*", r.Header.Get("Accept"))
+		}
+		switch r.URL.Path {
+		case "/rest/api/3/attachment/content/10000":
+			http.Redirect(w, r, media.URL+"/file/10000", http.StatusSeeOther)
+		case "/rest/api/3/attachment/thumbnail/10000":
+			if r.URL.RawQuery != "fallbackToDefault=true&height=100&width=200" {
+				t.Errorf("Query = %v", r.URL.RawQuery)
+			}
+			w.Write(content[:4])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	n, err := service.DownloadAttachment(ctx, "10000", &buf)
+	if err != nil {
+		t.Fatalf("DownloadAttachment() error = %v", err)
+	}
+	if n != int64(len(content)) || !bytes.Equal(buf.Bytes(), content) {
+		t.Errorf("DownloadAttachment() wrote %d bytes %q, want %q", n, buf.Bytes(), content)
+	}
+
+	buf.Reset()
+	if _, err := service.DownloadAttachmentThumbnail(ctx, "10000", AttachmentThumbnailOpts{Width: 200, Height: 100, FallbackToDefault: true}, &buf); err != nil {
+		t.Fatalf("DownloadAttachmentThumbnail() error = %v", err)
+	}
+	if buf.String() != "\x89PNG" {
+		t.Errorf("DownloadAttachmentThumbnail() wrote %q", buf.String())
+	}
+
+	if _, err := service.DownloadAttachment(ctx, "404", io.Discard); err == nil {
+		t.Error("DownloadAttachment() expected error for missing attachment, got nil")
+	}
+}
+
+func TestService_DeleteAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/rest/api/3/attachment/10000" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	if err := service.DeleteAttachment(context.Background(), "10000"); err != nil {
+		t.Errorf("DeleteAttachment() error = %v", err)
+	}
+	if err := service.DeleteAttachment(context.Background(), ""); err == nil {
+		t.Error("DeleteAttachment() expected error for missing ID, got nil")
+	}
+}
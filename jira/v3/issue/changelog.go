@@ -0,0 +1,200 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListChangelogs retrieves a page of the changelog of an issue, oldest change first.
+// Unlike the changelog embedded with expand=changelog, it is not truncated at 100 entries.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-changelog-get
+func (s *Service) ListChangelogs(ctx context.Context, issueIDOrKey string, opts IssueChangelogOpts) (*PagedChangelog, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_CHANGELOG_ENDPOINT, issueIDOrKey)
+	params := url.Values{}
+
+	if opts.StartAt > 0 {
+		params.Add("startAt", strconv.Itoa(opts.StartAt))
+	}
+	if opts.MaxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(opts.MaxResults))
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	changelogs := new(PagedChangelog)
+	if err := s.client.Do(req, changelogs); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return changelogs, nil
+}
+
+// ListAllChangelogs retrieves the whole changelog of an issue, starting at opts.StartAt and
+// requesting pages of opts.MaxResults changes until the last page
+func (s *Service) ListAllChangelogs(ctx context.Context, issueIDOrKey string, opts IssueChangelogOpts) (Changelogs, error) {
+	var changelogs Changelogs
+	for {
+		page, err := s.ListChangelogs(ctx, issueIDOrKey, opts)
+		if err != nil {
+			return changelogs, err
+		}
+
+		changelogs = append(changelogs, page.Values...)
+		opts.StartAt += len(page.Values)
+		if len(page.Values) == 0 || page.IsLast || opts.StartAt >= page.Total {
+			return changelogs, nil
+		}
+	}
+}
+
+// BulkFetchChangelogs retrieves a page of the changelogs of many issues, optionally only the changes to some fields
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-changelog-bulkfetch-post
+func (s *Service) BulkFetchChangelogs(ctx context.Context, request BulkChangelogRequest) (*BulkChangelogResponse, error) {
+	if len(request.IssueIDsOrKeys) == 0 {
+		return nil, fmt.Errorf("issue IDs or keys are required")
+	}
+	if len(request.IssueIDsOrKeys) > BULK_CHANGELOG_MAX_ISSUES {
+		return nil, fmt.Errorf("at most %d issues can be fetched at once, got %d", BULK_CHANGELOG_MAX_ISSUES, len(request.IssueIDsOrKeys))
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, CHANGELOG_BULK_FETCH_ENDPOINT, request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	response := new(BulkChangelogResponse)
+	if err := s.client.Do(req, response); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return response, nil
+}
+
+// BulkFetchAllChangelogs retrieves the changelogs of any number of issues, in batches of BULK_CHANGELOG_MAX_ISSUES
+// following every page. The changes of an issue split across pages are merged into one IssueChangelog.
+func (s *Service) BulkFetchAllChangelogs(ctx context.Context, issueIDsOrKeys []string, fieldIDs []string) ([]IssueChangelog, error) {
+	if len(issueIDsOrKeys) == 0 {
+		return nil, fmt.Errorf("issue IDs or keys are required")
+	}
+
+	var changelogs []IssueChangelog
+	index := map[string]int{}
+	for batch := range slices.Chunk(issueIDsOrKeys, BULK_CHANGELOG_MAX_ISSUES) {
+		request := BulkChangelogRequest{IssueIDsOrKeys: batch, FieldIDs: fieldIDs}
+		for {
+			page, err := s.BulkFetchChangelogs(ctx, request)
+			if err != nil {
+				return changelogs, err
+			}
+
+			for _, changelog := range page.IssueChangelogs {
+				if i, ok := index[changelog.IssueID]; ok {
+					changelogs[i].ChangeHistories = append(changelogs[i].ChangeHistories, changelog.ChangeHistories...)
+					continue
+				}
+				index[changelog.IssueID] = len(changelogs)
+				changelogs = append(changelogs, changelog)
+			}
+
+			if page.NextPageToken == "" || page.NextPageToken == request.NextPageToken {
+				break
+			}
+			request.NextPageToken = page.NextPageToken
+		}
+	}
+
+	return changelogs, nil
+}
+
+// FieldChange is a change of one field, taken from a changelog entry
+type FieldChange struct {
+	// The name of the field, e.g. "status" or "Story Points"
+	Field string
+
+	// The ID of the field, e.g. "status" or "customfield_10016"
+	FieldID string
+
+	// The value before and after the change as displayed, e.g. status names or user display names
+	From string
+	To   string
+
+	// The value before and after the change as stored, e.g. status IDs or account IDs
+	FromID string
+	ToID   string
+
+	// The user who made the change
+	Author SimpleUser
+
+	// When the change was made, zero when the changelog has no valid creation time
+	At time.Time
+}
+
+// Changelogs are changelog entries of an issue, with queries over the field changes they hold
+type Changelogs []Changelog
+
+// FieldChanges returns the changes of a field, given as a field ID or a field name (case-insensitive), oldest first.
+// Pass CHANGELOG_FIELD_ASSIGNEE for reassignments, or a custom field ID such as "customfield_10016".
+func (c Changelogs) FieldChanges(field string) []FieldChange {
+	if field == "" {
+		return nil
+	}
+
+	var changes []FieldChange
+	for _, changelog := range c {
+		at, _ := changelog.CreatedTime()
+		for _, item := range changelog.Items {
+			if item.FieldID != field && !strings.EqualFold(item.Field, field) {
+				continue
+			}
+			changes = append(changes, FieldChange{
+				Field:   item.Field,
+				FieldID: item.FieldID,
+				From:    item.FromString,
+				To:      item.ToString,
+				FromID:  item.From,
+				ToID:    item.To,
+				Author:  changelog.Author,
+				At:      at,
+			})
+		}
+	}
+
+	// Changelogs embedded with expand=changelog are newest first, the changelog API returns them oldest first
+	slices.SortStableFunc(changes, func(a, b FieldChange) int {
+		return a.At.Compare(b.At)
+	})
+	return changes
+}
+
+// StatusTransitions returns the status changes made from since (inclusive) until until (exclusive), oldest first.
+// A zero since or until leaves that end of the range open.
+func (c Changelogs) StatusTransitions(since, until time.Time) []FieldChange {
+	var transitions []FieldChange
+	for _, change := range c.FieldChanges(CHANGELOG_FIELD_STATUS) {
+		if !since.IsZero() && change.At.Before(since) {
+			continue
+		}
+		if !until.IsZero() && !change.At.Before(until) {
+			continue
+		}
+		transitions = append(transitions, change)
+	}
+	return transitions
+}
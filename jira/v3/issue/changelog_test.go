@@ -0,0 +1,276 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+func TestService_ListChangelogs(t *testing.T) {
+	tests := []struct {
+		name       string
+		issueKey   string
+		opts       IssueChangelogOpts
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "success",
+			issueKey:   "TEST-1",
+			wantURL:    "/rest/api/3/issue/TEST-1/changelog",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "success - with options",
+			issueKey:   "TEST-1",
+			opts:       IssueChangelogOpts{StartAt: 100, MaxResults: 50},
+			wantURL:    "/rest/api/3/issue/TEST-1/changelog?maxResults=50&startAt=100",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:    "error - missing key",
+			wantErr: true,
+		},
+		{
+			name:       "error - not found",
+			issueKey:   "TEST-404",
+			wantURL:    "/rest/api/3/issue/TEST-404/changelog",
+			wantErr:    true,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode == http.StatusOK {
+					w.Write([]byte(`{"startAt":0,"maxResults":100,"total":1,"isLast":true,"values":[
+						{"id":"10001","author":{"accountId":"abc"},"created":"2024-03-01T10:00:00.000+0000",
+						 "items":[{"field":"status","fieldId":"status","from":"1","fromString":"To Do","to":"3","toString":"In Progress"}]}]}`))
+				}
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			got, err := service.ListChangelogs(context.Background(), tt.issueKey, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListChangelogs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(got.Values) != 1 || got.Values[0].Items[0].ToString != "In Progress") {
+				t.Errorf("ListChangelogs() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestService_ListAllChangelogs(t *testing.T) {
+	const total = 7
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+
+		page := PagedChangelog{StartAt: startAt, MaxResults: 3, Total: total, IsLast: startAt+3 >= total}
+		for i := startAt; i < min(startAt+3, total); i++ {
+			page.Values = append(page.Values, Changelog{ID: fmt.Sprint(i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	changelogs, err := service.ListAllChangelogs(context.Background(), "TEST-1", IssueChangelogOpts{MaxResults: 3})
+	if err != nil {
+		t.Fatalf("ListAllChangelogs() error = %v", err)
+	}
+	if len(changelogs) != total {
+		t.Fatalf("ListAllChangelogs() returned %d changelogs, want %d", len(changelogs), total)
+	}
+	for i, c := range changelogs {
+		if c.ID != fmt.Sprint(i) {
+			t.Errorf("changelogs[%d].ID = %v, want %d", i, c.ID, i)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+}
+
+func TestService_BulkFetchChangelogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  BulkChangelogRequest
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			request:  BulkChangelogRequest{IssueIDsOrKeys: []string{"TEST-1", "10002"}, FieldIDs: []string{"status"}, MaxResults: 500},
+			wantBody: `{"issueIdsOrKeys":["TEST-1","10002"],"fieldIds":["status"],"maxResults":500}`,
+		},
+		{
+			name:    "error - no issues",
+			wantErr: true,
+		},
+		{
+			name:    "error - too many issues",
+			request: BulkChangelogRequest{IssueIDsOrKeys: make([]string, BULK_CHANGELOG_MAX_ISSUES+1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/changelog/bulkfetch" {
+					t.Errorf("request = %s %s, want POST /rest/api/3/changelog/bulkfetch", r.Method, r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tt.wantBody+"\n" && string(body) != tt.wantBody {
+					t.Errorf("body = %s, want %s", body, tt.wantBody)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"issueChangeLogs":[{"issueId":"10001","changeHistories":[{"id":"1"}]}],"nextPageToken":"next"}`))
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			got, err := service.BulkFetchChangelogs(context.Background(), tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BulkFetchChangelogs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(got.IssueChangelogs) != 1 || got.IssueChangelogs[0].IssueID != "10001" || got.NextPageToken != "next") {
+				t.Errorf("BulkFetchChangelogs() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestService_BulkFetchAllChangelogs(t *testing.T) {
+	var batches [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request BulkChangelogRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("decoding request: %v", err)
+		}
+		if !reflect.DeepEqual(request.FieldIDs, []string{"status"}) {
+			t.Errorf("FieldIDs = %v, want [status]", request.FieldIDs)
+		}
+
+		var response BulkChangelogResponse
+		switch request.NextPageToken {
+		case "":
+			batches = append(batches, request.IssueIDsOrKeys)
+			// The first issue of the batch spans two pages
+			response.IssueChangelogs = []IssueChangelog{{IssueID: request.IssueIDsOrKeys[0], ChangeHistories: Changelogs{{ID: "1"}}}}
+			response.NextPageToken = "page-2"
+		case "page-2":
+			response.IssueChangelogs = []IssueChangelog{{IssueID: request.IssueIDsOrKeys[0], ChangeHistories: Changelogs{{ID: "2"}}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	keys := make([]string, BULK_CHANGELOG_MAX_ISSUES+1)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("TEST-%d", i)
+	}
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	got, err := service.BulkFetchAllChangelogs(context.Background(), keys, []string{"status"})
+	if err != nil {
+		t.Fatalf("BulkFetchAllChangelogs() error = %v", err)
+	}
+
+	if len(batches) != 2 || len(batches[0]) != BULK_CHANGELOG_MAX_ISSUES || len(batches[1]) != 1 {
+		t.Errorf("batch sizes = %d, want %d and 1", len(batches), BULK_CHANGELOG_MAX_ISSUES)
+	}
+	want := []IssueChangelog{
+		{IssueID: "TEST-0", ChangeHistories: Changelogs{{ID: "1"}, {ID: "2"}}},
+		{IssueID: fmt.Sprintf("TEST-%d", BULK_CHANGELOG_MAX_ISSUES), ChangeHistories: Changelogs{{ID: "1"}, {ID: "2"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BulkFetchAllChangelogs() = %+v, want %+v", got, want)
+	}
+}
+
+func TestChangelogs_FieldChanges(t *testing.T) {
+	mia := SimpleUser{AccountID: "abc", DisplayName: "Mia"}
+	changelogs := Changelogs{
+		// Newest first, as embedded with expand=changelog
+		{Author: mia, Created: "2024-03-03T09:00:00.000+0000", Items: []ChangelogDetails{
+			{Field: "status", FieldID: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done"},
+		}},
+		{Author: mia, Created: "2024-03-02T09:00:00.000+0000", Items: []ChangelogDetails{
+			{Field: "assignee", FieldID: "assignee", From: "", To: "abc", ToString: "Mia"},
+			{Field: "Story Points", FieldID: "customfield_10016", FromString: "3", ToString: "5"},
+		}},
+		{Author: mia, Created: "2024-03-01T09:00:00.000+0000", Items: []ChangelogDetails{
+			{Field: "status", FieldID: "status", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"},
+		}},
+	}
+
+	assignee := changelogs.FieldChanges(CHANGELOG_FIELD_ASSIGNEE)
+	if len(assignee) != 1 {
+		t.Fatalf("FieldChanges(assignee) = %+v, want one change", assignee)
+	}
+	if at := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC); !assignee[0].At.Equal(at) {
+		t.Errorf("At = %v, want %v", assignee[0].At, at)
+	}
+	assignee[0].At = time.Time{}
+	want := FieldChange{Field: "assignee", FieldID: "assignee", To: "Mia", ToID: "abc", Author: mia}
+	if !reflect.DeepEqual(assignee[0], want) {
+		t.Errorf("FieldChanges(assignee) = %+v, want %+v", assignee[0], want)
+	}
+
+	if points := changelogs.FieldChanges("story points"); len(points) != 1 || points[0].To != "5" {
+		t.Errorf("FieldChanges(story points) = %+v, want the change to 5", points)
+	}
+	if none := changelogs.FieldChanges(""); none != nil {
+		t.Errorf("FieldChanges(\"\") = %+v, want none", none)
+	}
+
+	tests := []struct {
+		name         string
+		since, until time.Time
+		want         []string
+	}{
+		{name: "all, oldest first", want: []string{"In Progress", "Done"}},
+		{name: "since is inclusive", since: time.Date(2024, 3, 3, 9, 0, 0, 0, time.UTC), want: []string{"Done"}},
+		{name: "until is exclusive", until: time.Date(2024, 3, 3, 9, 0, 0, 0, time.UTC), want: []string{"In Progress"}},
+		{name: "empty range", since: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, transition := range changelogs.StatusTransitions(tt.since, tt.until) {
+				got = append(got, transition.To)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StatusTransitions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
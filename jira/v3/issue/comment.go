@@ -0,0 +1,196 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListComments retrieves a page of comments for an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-get
+func (s *Service) ListComments(ctx context.Context, issueIDOrKey string, opts IssueCommentOpts) (*PagedComment, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_COMMENTS_ENDPOINT, issueIDOrKey)
+	params := url.Values{}
+
+	if opts.StartAt > 0 {
+		params.Add("startAt", strconv.Itoa(opts.StartAt))
+	}
+	if opts.MaxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(opts.MaxResults))
+	}
+	if opts.OrderBy != "" {
+		params.Add("orderBy", opts.OrderBy)
+	}
+	if len(opts.Expand) > 0 {
+		params.Add("expand", strings.Join(opts.Expand, ","))
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	comments := new(PagedComment)
+	if err := s.client.Do(req, comments); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return comments, nil
+}
+
+// ListAllComments retrieves every comment of an issue, starting at opts.StartAt and
+// requesting pages of opts.MaxResults comments until the total is reached
+func (s *Service) ListAllComments(ctx context.Context, issueIDOrKey string, opts IssueCommentOpts) ([]IssueComment, error) {
+	var comments []IssueComment
+	for {
+		page, err := s.ListComments(ctx, issueIDOrKey, opts)
+		if err != nil {
+			return comments, err
+		}
+
+		comments = append(comments, page.Comments...)
+		opts.StartAt += len(page.Comments)
+		if len(page.Comments) == 0 || opts.StartAt >= page.Total {
+			return comments, nil
+		}
+	}
+}
+
+// GetComment retrieves a comment of an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-id-get
+func (s *Service) GetComment(ctx context.Context, issueIDOrKey, commentID string, expand []string) (*IssueComment, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+	if commentID == "" {
+		return nil, fmt.Errorf("comment ID is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_COMMENT_DETAIL_ENDPOINT, issueIDOrKey, commentID)
+	if len(expand) > 0 {
+		path = fmt.Sprintf("%s?expand=%s", path, url.QueryEscape(strings.Join(expand, ",")))
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	comment := new(IssueComment)
+	if err := s.client.Do(req, comment); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return comment, nil
+}
+
+// AddComment adds a comment to an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-post
+func (s *Service) AddComment(ctx context.Context, issueIDOrKey string, request CommentRequest, expand []string) (*IssueComment, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+	if request.Body == nil {
+		return nil, fmt.Errorf("comment body is required")
+	}
+	if err := s.checkADF(request); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(ISSUE_COMMENTS_ENDPOINT, issueIDOrKey)
+	if len(expand) > 0 {
+		path = fmt.Sprintf("%s?expand=%s", path, url.QueryEscape(strings.Join(expand, ",")))
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	comment := new(IssueComment)
+	if err := s.client.Do(req, comment); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return comment, nil
+}
+
+// UpdateComment replaces the body and visibility of a comment
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-id-put
+func (s *Service) UpdateComment(ctx context.Context, issueIDOrKey, commentID string, request CommentRequest, opts IssueCommentUpdateOpts) (*IssueComment, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+	if commentID == "" {
+		return nil, fmt.Errorf("comment ID is required")
+	}
+	if request.Body == nil {
+		return nil, fmt.Errorf("comment body is required")
+	}
+	if err := s.checkADF(request); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(ISSUE_COMMENT_DETAIL_ENDPOINT, issueIDOrKey, commentID)
+	params := url.Values{}
+
+	if len(opts.Expand) > 0 {
+		params.Add("expand", strings.Join(opts.Expand, ","))
+	}
+	if opts.NotifyUsers != nil {
+		params.Add("notifyUsers", strconv.FormatBool(*opts.NotifyUsers))
+	}
+	if opts.OverrideEditableFlag {
+		params.Add("overrideEditableFlag", "true")
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	comment := new(IssueComment)
+	if err := s.client.Do(req, comment); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment deletes a comment from an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-comments/#api-rest-api-3-issue-issueidorkey-comment-id-delete
+func (s *Service) DeleteComment(ctx context.Context, issueIDOrKey, commentID string) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+	if commentID == "" {
+		return fmt.Errorf("comment ID is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_COMMENT_DETAIL_ENDPOINT, issueIDOrKey, commentID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/utils"
+)
+
+var testCommentBody = map[string]interface{}{
+	"type":    "doc",
+	"version": 1,
+	"content": []interface{}{
+		map[string]interface{}{
+			"type":    "paragraph",
+			"content": []interface{}{map[string]interface{}{"type": "text", "text": "Deployed"}},
+		},
+	},
+}
+
+func TestService_ListComments(t *testing.T) {
+	tests := []struct {
+		name       string
+		issueKey   string
+		opts       IssueCommentOpts
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "success",
+			issueKey:   "TEST-1",
+			wantURL:    "/rest/api/3/issue/TEST-1/comment",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:     "success - with options",
+			issueKey: "TEST-1",
+			opts: IssueCommentOpts{
+				StartAt:    10,
+				MaxResults: 5,
+				OrderBy:    "-created",
+				Expand:     []string{COMMENT_EXPAND_RENDERED_BODY},
+			},
+			wantURL:    "/rest/api/3/issue/TEST-1/comment?expand=renderedBody&maxResults=5&orderBy=-created&startAt=10",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:    "error - missing key",
+			wantErr: true,
+		},
+		{
+			name:       "error - not found",
+			issueKey:   "TEST-404",
+			wantURL:    "/rest/api/3/issue/TEST-404/comment",
+			wantErr:    true,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode == http.StatusOK {
+					json.NewEncoder(w).Encode(PagedComment{
+						Total:    1,
+						Comments: []IssueComment{{ID: "10000", Body: testCommentBody, RenderedBody: "<p>Deployed</p>"}},
+					})
+				}
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			got, err := service.ListComments(context.Background(), tt.issueKey, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListComments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(got.Comments) != 1 || got.Comments[0].RenderedBody != "<p>Deployed</p>") {
+				t.Errorf("ListComments() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestService_ListAllComments(t *testing.T) {
+	const total = 7
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+		if maxResults != 3 {
+			t.Errorf("maxResults = %d, want 3", maxResults)
+		}
+
+		page := PagedComment{StartAt: startAt, MaxResults: maxResults, Total: total}
+		for i := startAt; i < min(startAt+maxResults, total); i++ {
+			page.Comments = append(page.Comments, IssueComment{ID: fmt.Sprint(i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	comments, err := service.ListAllComments(context.Background(), "TEST-1", IssueCommentOpts{MaxResults: 3})
+	if err != nil {
+		t.Fatalf("ListAllComments() error = %v", err)
+	}
+	if len(comments) != total {
+		t.Fatalf("ListAllComments() returned %d comments, want %d", len(comments), total)
+	}
+	for i, c := range comments {
+		if c.ID != fmt.Sprint(i) {
+			t.Errorf("comments[%d].ID = %v, want %d", i, c.ID, i)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+}
+
+func TestService_AddComment(t *testing.T) {
+	tests := []struct {
+		name     string
+		issueKey string
+		request  CommentRequest
+		expand   []string
+		wantURL  string
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			issueKey: "TEST-1",
+			request:  CommentRequest{Body: testCommentBody},
+			wantURL:  "/rest/api/3/issue/TEST-1/comment",
+			wantBody: `{"body":{"content":[{"content":[{"text":"Deployed","type":"text"}],"type":"paragraph"}],"type":"doc","version":1}}`,
+		},
+		{
+			name:     "success - restricted to role",
+			issueKey: "TEST-1",
+			request: CommentRequest{
+				Body:       testCommentBody,
+				Visibility: &Visibility{Type: VISIBILITY_TYPE_ROLE, Value: "Administrators"},
+			},
+			expand:   []string{COMMENT_EXPAND_RENDERED_BODY},
+			wantURL:  "/rest/api/3/issue/TEST-1/comment?expand=renderedBody",
+			wantBody: `{"body":{"content":[{"content":[{"text":"Deployed","type":"text"}],"type":"paragraph"}],"type":"doc","version":1},"visibility":{"type":"role","value":"Administrators"}}`,
+		},
+		{
+			name:     "error - missing body",
+			issueKey: "TEST-1",
+			wantErr:  true,
+		},
+		{
+			name:    "error - missing key",
+			request: CommentRequest{Body: testCommentBody},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want POST", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if strings.TrimSpace(string(body)) != tt.wantBody {
+					t.Errorf("Body = %s, want %s", body, tt.wantBody)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(IssueComment{ID: "10001", Visibility: tt.request.Visibility})
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			got, err := service.AddComment(context.Background(), tt.issueKey, tt.request, tt.expand)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddComment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.ID != "10001" {
+				t.Errorf("AddComment() ID = %v, want 10001", got.ID)
+			}
+		})
+	}
+}
+
+func TestService_GetComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/comment/10000" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		if r.URL.Query().Get("expand") != "renderedBody" {
+			t.Errorf("expand = %v, want renderedBody", r.URL.Query().Get("expand"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(IssueComment{
+			ID:         "10000",
+			Visibility: &Visibility{Type: VISIBILITY_TYPE_GROUP, Identifier: "276f955c-63d7-42c8-9520-92d01dca0625"},
+		})
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	got, err := service.GetComment(context.Background(), "TEST-1", "10000", []string{COMMENT_EXPAND_RENDERED_BODY})
+	if err != nil {
+		t.Fatalf("GetComment() error = %v", err)
+	}
+	if got.Visibility == nil || got.Visibility.Type != VISIBILITY_TYPE_GROUP {
+		t.Errorf("GetComment() Visibility = %+v, want group", got.Visibility)
+	}
+
+	if _, err := service.GetComment(context.Background(), "TEST-1", "", nil); err == nil {
+		t.Error("GetComment() expected error for missing comment ID, got nil")
+	}
+}
+
+func TestService_UpdateComment(t *testing.T) {
+	tests := []struct {
+		name      string
+		commentID string
+		request   CommentRequest
+		opts      IssueCommentUpdateOpts
+		wantURL   string
+		wantErr   bool
+	}{
+		{
+			name:      "success",
+			commentID: "10000",
+			request:   CommentRequest{Body: testCommentBody},
+			wantURL:   "/rest/api/3/issue/TEST-1/comment/10000",
+		},
+		{
+			name:      "success - with options",
+			commentID: "10000",
+			request:   CommentRequest{Body: testCommentBody},
+			opts:      IssueCommentUpdateOpts{NotifyUsers: utils.Bool(false), OverrideEditableFlag: true},
+			wantURL:   "/rest/api/3/issue/TEST-1/comment/10000?notifyUsers=false&overrideEditableFlag=true",
+		},
+		{
+			name:    "error - missing comment ID",
+			request: CommentRequest{Body: testCommentBody},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				if r.Method != http.MethodPut {
+					t.Errorf("Method = %v, want PUT", r.Method)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(IssueComment{ID: tt.commentID})
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			_, err := service.UpdateComment(context.Background(), "TEST-1", tt.commentID, tt.request, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateComment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_DeleteComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		if r.URL.Path == "/rest/api/3/issue/TEST-1/comment/404" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	if err := service.DeleteComment(context.Background(), "TEST-1", "10000"); err != nil {
+		t.Errorf("DeleteComment() error = %v", err)
+	}
+	if err := service.DeleteComment(context.Background(), "TEST-1", "404"); err == nil {
+		t.Error("DeleteComment() expected error for missing comment, got nil")
+	}
+}
@@ -1,5 +1,7 @@
 package issue
 
+import "time"
+
 const (
 	// Issue API Group endpoints
 	ISSUE_GET_ENDPOINT    = "/rest/api/3/issue/%s"
@@ -19,7 +21,10 @@ const (
 	ISSUE_COMMENT_DETAIL_ENDPOINT = "/rest/api/3/issue/%s/comment/%s"
 
 	// Issue attachments
-	ISSUE_ATTACHMENTS_ENDPOINT = "/rest/api/3/issue/%s/attachments"
+	ISSUE_ATTACHMENTS_ENDPOINT    = "/rest/api/3/issue/%s/attachments"
+	ATTACHMENT_ENDPOINT           = "/rest/api/3/attachment/%s"
+	ATTACHMENT_CONTENT_ENDPOINT   = "/rest/api/3/attachment/content/%s"
+	ATTACHMENT_THUMBNAIL_ENDPOINT = "/rest/api/3/attachment/thumbnail/%s"
 
 	// Issue watchers
 	ISSUE_WATCHERS_ENDPOINT = "/rest/api/3/issue/%s/watchers"
@@ -32,17 +37,64 @@ const (
 	ISSUE_WORKLOG_DETAIL_ENDPOINT = "/rest/api/3/issue/%s/worklog/%s"
 
 	// Issue links
-	ISSUE_LINKS_ENDPOINT = "/rest/api/3/issueLink"
+	ISSUE_LINKS_ENDPOINT       = "/rest/api/3/issueLink"
+	ISSUE_LINK_DETAIL_ENDPOINT = "/rest/api/3/issueLink/%s"
+	ISSUE_LINK_TYPES_ENDPOINT  = "/rest/api/3/issueLinkType"
 
 	// Issue types
-	ISSUE_TYPES_ENDPOINT = "/rest/api/3/issuetype"
+	ISSUE_TYPES_ENDPOINT         = "/rest/api/3/issuetype"
+	ISSUE_TYPE_DETAIL_ENDPOINT   = "/rest/api/3/issuetype/%s"
+	ISSUE_TYPES_PROJECT_ENDPOINT = "/rest/api/3/issuetype/project"
 
 	// Issue priorities
-	ISSUE_PRIORITIES_ENDPOINT = "/rest/api/3/priority"
+	ISSUE_PRIORITIES_ENDPOINT      = "/rest/api/3/priority"
+	ISSUE_PRIORITY_DETAIL_ENDPOINT = "/rest/api/3/priority/%s"
 
 	// Issue statuses
-	ISSUE_STATUSES_ENDPOINT = "/rest/api/3/status"
+	ISSUE_STATUSES_ENDPOINT      = "/rest/api/3/status"
+	ISSUE_STATUS_DETAIL_ENDPOINT = "/rest/api/3/status/%s"
 
 	// Issue fields
 	ISSUE_FIELDS_ENDPOINT = "/rest/api/3/field"
+
+	// Issue changelogs
+	ISSUE_CHANGELOG_ENDPOINT      = "/rest/api/3/issue/%s/changelog"
+	CHANGELOG_BULK_FETCH_ENDPOINT = "/rest/api/3/changelog/bulkfetch"
+)
+
+const (
+	// Visibility types for comments and worklogs
+	VISIBILITY_TYPE_ROLE  = "role"
+	VISIBILITY_TYPE_GROUP = "group"
+
+	// Comment expand options
+	COMMENT_EXPAND_RENDERED_BODY = "renderedBody"
+
+	// Worklog estimate adjustment modes
+	ADJUST_ESTIMATE_NEW    = "new"    // Set the remaining estimate to NewEstimate
+	ADJUST_ESTIMATE_LEAVE  = "leave"  // Leave the remaining estimate unchanged
+	ADJUST_ESTIMATE_MANUAL = "manual" // Reduce the estimate by ReduceBy, or increase it by IncreaseBy on delete
+	ADJUST_ESTIMATE_AUTO   = "auto"   // Adjust the estimate by the time spent
+)
+
+const (
+	// Name of the built-in link type whose outward description is "blocks" and inward description is "is blocked by"
+	ISSUE_LINK_TYPE_BLOCKS = "Blocks"
+
+	// Directions a link walk follows, relative to the issue being expanded
+	LINK_DIRECTION_INWARD  = "inward"  // Follow links to issues on the inward side, e.g. the issues blocking it
+	LINK_DIRECTION_OUTWARD = "outward" // Follow links to issues on the outward side, e.g. the issues it blocks
+	LINK_DIRECTION_BOTH    = "both"    // Follow links in both directions
+)
+
+const (
+	// Maximum number of issues in a bulk changelog fetch
+	BULK_CHANGELOG_MAX_ISSUES = 1000
+
+	// Field IDs of the changes queried by Changelogs.StatusTransitions and commonly passed to Changelogs.FieldChanges
+	CHANGELOG_FIELD_STATUS   = "status"
+	CHANGELOG_FIELD_ASSIGNEE = "assignee"
 )
+
+// METADATA_CACHE_TTL is how long issue types, priorities, statuses and fields are cached by default
+const METADATA_CACHE_TTL = 10 * time.Minute
@@ -0,0 +1,368 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// ErrFieldNotSet is returned when reading a field that is missing from an issue or null
+var ErrFieldNotSet = errors.New("field not set")
+
+// ErrAmbiguousField is returned when a display name matches more than one field
+var ErrAmbiguousField = errors.New("ambiguous field name")
+
+// ErrSystemField is returned when CustomField is given a system field, such as "Labels",
+// which is decoded into IssueFields rather than kept with the custom fields
+var ErrSystemField = errors.New("system field, use Issue.Fields")
+
+// ErrNoFieldRegistry is returned when reading a field by display name from an issue that has no field registry,
+// e.g. an issue fetched before Service.FieldRegistry was called or decoded by hand. Load the registry with
+// Service.FieldRegistry, set it with Issue.SetFieldRegistry or read the field by ID.
+var ErrNoFieldRegistry = errors.New("no field registry to resolve field names")
+
+// FieldRegistry resolves field display names to field IDs.
+// Custom field IDs differ between Jira instances, so a registry is built per instance from its field list.
+type FieldRegistry struct {
+	fields []responsetypes.Field
+	byID   map[string]int
+	byName map[string][]int
+}
+
+// NewFieldRegistry creates a registry from the fields returned by Service.ListFields
+func NewFieldRegistry(fields []responsetypes.Field) *FieldRegistry {
+	r := &FieldRegistry{
+		fields: slices.Clone(fields),
+		byID:   make(map[string]int, len(fields)),
+		byName: make(map[string][]int, len(fields)),
+	}
+	for i, field := range r.fields {
+		r.byID[field.ID] = i
+		name := strings.ToLower(field.Name)
+		r.byName[name] = append(r.byName[name], i)
+	}
+	return r
+}
+
+// Field returns the field with the given ID or, failing that, the given display name.
+// Names are matched case-insensitively. When several fields share the name, ErrAmbiguousField is returned
+// and the field has to be referenced by ID.
+func (r *FieldRegistry) Field(nameOrID string) (responsetypes.Field, error) {
+	if i, ok := r.byID[nameOrID]; ok {
+		return r.fields[i], nil
+	}
+
+	matches := r.byName[strings.ToLower(nameOrID)]
+	switch len(matches) {
+	case 0:
+		return responsetypes.Field{}, fmt.Errorf("field %q: %w", nameOrID, ErrMetadataNotFound)
+	case 1:
+		return r.fields[matches[0]], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, i := range matches {
+		ids = append(ids, r.fields[i].ID)
+	}
+	return responsetypes.Field{}, fmt.Errorf("field %q matches %s: %w", nameOrID, strings.Join(ids, ", "), ErrAmbiguousField)
+}
+
+// ID resolves a field ID or display name, e.g. "Story Points", to the field ID, e.g. "customfield_10016"
+func (r *FieldRegistry) ID(nameOrID string) (string, error) {
+	field, err := r.Field(nameOrID)
+	if err != nil {
+		return "", err
+	}
+	return field.ID, nil
+}
+
+// Fields returns every field in the registry
+func (r *FieldRegistry) Fields() []responsetypes.Field {
+	return slices.Clone(r.fields)
+}
+
+// FieldRegistry returns the field registry of the Jira instance, built from ListFields and cached with the other metadata.
+// Once loaded, the registry is attached to the issues returned by Get and SearchJQL so that
+// Issue.CustomField accepts field names.
+func (s *Service) FieldRegistry(ctx context.Context) (*FieldRegistry, error) {
+	registry, err := cachedMetadata(ctx, s.metadata, "fieldregistry", func(ctx context.Context) (*FieldRegistry, error) {
+		fields, err := s.ListFields(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return NewFieldRegistry(fields), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.registry.Store(registry)
+	return registry, nil
+}
+
+// attachFieldRegistry sets the last loaded field registry on the issues
+func (s *Service) attachFieldRegistry(issues []Issue) {
+	registry := s.registry.Load()
+	if registry == nil {
+		return
+	}
+	for i := range issues {
+		issues[i].registry = registry
+	}
+}
+
+// resolveCustomFields returns a copy of fields with the values of custom, keyed by field name, added under their field IDs
+func (s *Service) resolveCustomFields(ctx context.Context, fields, custom map[string]interface{}) (map[string]interface{}, error) {
+	if len(custom) == 0 {
+		return fields, nil
+	}
+
+	registry, err := s.FieldRegistry(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading field registry: %w", err)
+	}
+
+	resolved := maps.Clone(fields)
+	if resolved == nil {
+		resolved = make(map[string]interface{}, len(custom))
+	}
+	for name, value := range custom {
+		id, err := registry.ID(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := resolved[id]; ok {
+			return nil, fmt.Errorf("field %q is set both by name and by ID %s", name, id)
+		}
+		resolved[id] = value
+	}
+	return resolved, nil
+}
+
+// SetFieldRegistry sets the registry used by CustomField to resolve field names.
+// Issues returned by the service get the registry automatically once Service.FieldRegistry has been called.
+func (i *Issue) SetFieldRegistry(registry *FieldRegistry) {
+	i.registry = registry
+}
+
+// CustomField returns the value of a field without a dedicated struct field, given by display name or ID,
+// e.g. issue.CustomField("Story Points").Float()
+//
+// Field IDs, such as "customfield_10016", are read directly. Display names need a field registry: call
+// Service.FieldRegistry before fetching the issue, or SetFieldRegistry on it. Without one, reading a field
+// by name returns ErrNoFieldRegistry. CustomField never makes a request.
+// System fields, such as "Labels" or "Due date", are read from Fields and return ErrSystemField.
+func (i *Issue) CustomField(nameOrID string) FieldValue {
+	if _, ok := i.Fields.Unknowns[nameOrID]; ok || strings.HasPrefix(nameOrID, "customfield_") {
+		return FieldValue{raw: i.Fields.Unknowns[nameOrID]}
+	}
+
+	if i.registry == nil {
+		return FieldValue{err: fmt.Errorf("field %q: %w", nameOrID, ErrNoFieldRegistry)}
+	}
+
+	field, err := i.registry.Field(nameOrID)
+	if err != nil {
+		return FieldValue{err: err}
+	}
+	if !field.Custom && !strings.HasPrefix(field.ID, "customfield_") {
+		return FieldValue{err: fmt.Errorf("field %q is %s: %w", nameOrID, field.ID, ErrSystemField)}
+	}
+	return FieldValue{raw: i.Fields.Unknowns[field.ID]}
+}
+
+// FieldValue is the raw JSON value of an issue field with typed accessors.
+// Every accessor returns ErrFieldNotSet when the field is missing or null.
+type FieldValue struct {
+	raw json.RawMessage
+	err error
+}
+
+// Exists reports whether the field is present on the issue and not null
+func (v FieldValue) Exists() bool {
+	return v.err == nil && len(v.raw) > 0 && string(v.raw) != "null"
+}
+
+// Raw returns the JSON value of the field
+func (v FieldValue) Raw() (json.RawMessage, error) {
+	if err := v.check(); err != nil {
+		return nil, err
+	}
+	return v.raw, nil
+}
+
+// Decode unmarshals the JSON value of the field into target
+func (v FieldValue) Decode(target interface{}) error {
+	if err := v.check(); err != nil {
+		return err
+	}
+	return json.Unmarshal(v.raw, target)
+}
+
+// Float returns the value of a number field, e.g. story points
+func (v FieldValue) Float() (float64, error) {
+	var f float64
+	if err := v.Decode(&f); err != nil {
+		return 0, err
+	}
+	return f, nil
+}
+
+// Int returns the value of a number field holding a whole number
+func (v FieldValue) Int() (int64, error) {
+	f, err := v.Float()
+	if err != nil {
+		return 0, err
+	}
+	if f != math.Trunc(f) {
+		return 0, fmt.Errorf("field value %v is not a whole number", f)
+	}
+	return int64(f), nil
+}
+
+// Bool returns the value of a boolean field
+func (v FieldValue) Bool() (bool, error) {
+	var b bool
+	if err := v.Decode(&b); err != nil {
+		return false, err
+	}
+	return b, nil
+}
+
+// String returns the value of a text field, or the value or name of a select option or other named object
+func (v FieldValue) String() (string, error) {
+	if err := v.check(); err != nil {
+		return "", err
+	}
+	return decodeFieldText(v.raw)
+}
+
+// Strings returns the values of a multi-value field, e.g. labels, a multi-select or a list of versions
+func (v FieldValue) Strings() ([]string, error) {
+	var items []json.RawMessage
+	if err := v.Decode(&items); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		text, err := decodeFieldText(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, text)
+	}
+	return values, nil
+}
+
+// User returns the value of a user picker field
+func (v FieldValue) User() (*SimpleUser, error) {
+	user := new(SimpleUser)
+	if err := v.Decode(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// check returns the lookup error, or ErrFieldNotSet when there is no value
+func (v FieldValue) check() error {
+	if v.err != nil {
+		return v.err
+	}
+	if !v.Exists() {
+		return ErrFieldNotSet
+	}
+	return nil
+}
+
+// decodeFieldText decodes a JSON string, or the "value" or "name" of a JSON object
+func decodeFieldText(raw json.RawMessage) (string, error) {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text, nil
+	}
+
+	var named struct {
+		Value *string `json:"value"`
+		Name  *string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &named); err != nil {
+		return "", fmt.Errorf("field value %s is not text: %w", raw, err)
+	}
+	switch {
+	case named.Value != nil:
+		return *named.Value, nil
+	case named.Name != nil:
+		return *named.Name, nil
+	}
+	return "", fmt.Errorf("field value %s has no value or name", raw)
+}
+
+// issueFieldKeys returns the JSON keys of the IssueFields struct fields
+var issueFieldKeys = sync.OnceValue(func() map[string]bool {
+	keys := map[string]bool{}
+	t := reflect.TypeFor[IssueFields]()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+	return keys
+})
+
+// UnmarshalJSON decodes the issue fields, keeping fields without a dedicated struct field in Unknowns
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	type alias IssueFields
+	var fields alias
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	keys := issueFieldKeys()
+	for key, value := range raw {
+		if keys[key] {
+			continue
+		}
+		if fields.Unknowns == nil {
+			fields.Unknowns = map[string]json.RawMessage{}
+		}
+		fields.Unknowns[key] = value
+	}
+
+	*f = IssueFields(fields)
+	return nil
+}
+
+// MarshalJSON encodes the issue fields together with the fields kept in Unknowns
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	type alias IssueFields
+	data, err := json.Marshal(alias(f))
+	if err != nil || len(f.Unknowns) == 0 {
+		return data, err
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	for key, value := range f.Unknowns {
+		if _, ok := merged[key]; !ok {
+			merged[key] = value
+		}
+	}
+	return json.Marshal(merged)
+}
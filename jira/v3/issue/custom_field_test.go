@@ -0,0 +1,267 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+const customFieldsJSON = `[
+	{"id":"summary","name":"Summary"},
+	{"id":"customfield_10016","name":"Story Points","custom":true},
+	{"id":"customfield_10020","name":"Sprint","custom":true},
+	{"id":"customfield_10030","name":"Team","custom":true},
+	{"id":"customfield_10031","name":"Team","custom":true},
+	{"id":"customfield_10040","name":"Reviewer","custom":true},
+	{"id":"customfield_10050","name":"Components Affected","custom":true}
+]`
+
+const customFieldIssueJSON = `{
+	"id": "10001",
+	"key": "TEST-1",
+	"fields": {
+		"summary": "Build failed",
+		"labels": ["ci"],
+		"customfield_10016": 5,
+		"customfield_10020": null,
+		"customfield_10030": {"id": "1", "value": "Platform"},
+		"customfield_10040": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Mia Krystof"},
+		"customfield_10050": [{"value": "API"}, {"name": "UI"}, "CLI"],
+		"customfield_10060": true
+	}
+}`
+
+func TestIssueFields_JSONRoundTrip(t *testing.T) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(customFieldIssueJSON), &issue); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if issue.Fields.Summary != "Build failed" {
+		t.Errorf("Summary = %v, want Build failed", issue.Fields.Summary)
+	}
+	if _, ok := issue.Fields.Unknowns["summary"]; ok {
+		t.Error("Unknowns contains summary, want only fields without a struct field")
+	}
+	if got := len(issue.Fields.Unknowns); got != 6 {
+		t.Errorf("len(Unknowns) = %d, want 6", got)
+	}
+
+	data, err := json.Marshal(issue.Fields)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if string(fields["customfield_10016"]) != "5" || string(fields["summary"]) != `"Build failed"` {
+		t.Errorf("marshalled fields = %s", data)
+	}
+}
+
+func TestFieldRegistry(t *testing.T) {
+	var fields []responsetypes.Field
+	if err := json.Unmarshal([]byte(customFieldsJSON), &fields); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	registry := NewFieldRegistry(fields)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "by name", input: "Story Points", want: "customfield_10016"},
+		{name: "case-insensitive name", input: "story points", want: "customfield_10016"},
+		{name: "by ID", input: "customfield_10020", want: "customfield_10020"},
+		{name: "system field", input: "Summary", want: "summary"},
+		{name: "ambiguous name", input: "Team", wantErr: ErrAmbiguousField},
+		{name: "unknown name", input: "Epic Link", wantErr: ErrMetadataNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := registry.ID(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ID() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssue_CustomField(t *testing.T) {
+	var fields []responsetypes.Field
+	if err := json.Unmarshal([]byte(customFieldsJSON), &fields); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	var issue Issue
+	if err := json.Unmarshal([]byte(customFieldIssueJSON), &issue); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	issue.SetFieldRegistry(NewFieldRegistry(fields))
+
+	points, err := issue.CustomField("Story Points").Float()
+	if err != nil || points != 5 {
+		t.Errorf("Float() = %v, %v, want 5", points, err)
+	}
+	count, err := issue.CustomField("Story Points").Int()
+	if err != nil || count != 5 {
+		t.Errorf("Int() = %v, %v, want 5", count, err)
+	}
+	team, err := issue.CustomField("customfield_10030").String()
+	if err != nil || team != "Platform" {
+		t.Errorf("String() = %v, %v, want Platform", team, err)
+	}
+	components, err := issue.CustomField("Components Affected").Strings()
+	if err != nil || !reflect.DeepEqual(components, []string{"API", "UI", "CLI"}) {
+		t.Errorf("Strings() = %v, %v", components, err)
+	}
+	reviewer, err := issue.CustomField("Reviewer").User()
+	if err != nil || reviewer.DisplayName != "Mia Krystof" {
+		t.Errorf("User() = %+v, %v", reviewer, err)
+	}
+	// Fields missing from the registry can still be read by ID
+	flag, err := issue.CustomField("customfield_10060").Bool()
+	if err != nil || !flag {
+		t.Errorf("Bool() = %v, %v, want true", flag, err)
+	}
+
+	if issue.CustomField("Sprint").Exists() {
+		t.Error("Exists() = true for a null field, want false")
+	}
+	if _, err := issue.CustomField("Sprint").Strings(); !errors.Is(err, ErrFieldNotSet) {
+		t.Errorf("Strings() error = %v, want ErrFieldNotSet", err)
+	}
+	if _, err := issue.CustomField("Epic Link").Raw(); !errors.Is(err, ErrMetadataNotFound) {
+		t.Errorf("Raw() error = %v, want ErrMetadataNotFound", err)
+	}
+	if _, err := issue.CustomField("Summary").String(); !errors.Is(err, ErrSystemField) {
+		t.Errorf("String() error = %v, want ErrSystemField", err)
+	}
+	if _, err := issue.CustomField("Team").String(); !errors.Is(err, ErrAmbiguousField) {
+		t.Errorf("String() error = %v, want ErrAmbiguousField", err)
+	}
+	if _, err := issue.CustomField("Components Affected").Float(); err == nil {
+		t.Error("Float() expected error for an array value, got nil")
+	}
+
+	// Without a registry only field IDs can be read
+	issue.SetFieldRegistry(nil)
+	if points, err := issue.CustomField("customfield_10016").Float(); err != nil || points != 5 {
+		t.Errorf("Float() by ID without a registry = %v, %v, want 5", points, err)
+	}
+	if _, err := issue.CustomField("Story Points").Float(); !errors.Is(err, ErrNoFieldRegistry) {
+		t.Errorf("Float() by name without a registry error = %v, want ErrNoFieldRegistry", err)
+	}
+}
+
+func TestService_CustomFieldsByName(t *testing.T) {
+	var gotFields map[string]interface{}
+	fieldRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/rest/api/3/field":
+			fieldRequests++
+			w.Write([]byte(customFieldsJSON))
+		case r.URL.Path == "/rest/api/3/issue/TEST-1" && r.Method == http.MethodGet:
+			w.Write([]byte(customFieldIssueJSON))
+		case r.URL.Path == "/rest/api/3/issue" || r.URL.Path == "/rest/api/3/issue/TEST-1":
+			var body struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			gotFields = body.Fields
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"id":"10001","key":"TEST-1"}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	// Issues fetched before the registry is loaded only resolve field IDs, without requesting the registry
+	issue, err := service.Get(ctx, "TEST-1", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if flag, err := issue.CustomField("customfield_10060").Bool(); err != nil || !flag {
+		t.Errorf("Bool() = %v, %v, want true", flag, err)
+	}
+	if _, err := issue.CustomField("Story Points").Float(); !errors.Is(err, ErrNoFieldRegistry) {
+		t.Errorf("Float() error = %v, want ErrNoFieldRegistry", err)
+	}
+	if fieldRequests != 0 {
+		t.Errorf("field requests = %d, want none from CustomField", fieldRequests)
+	}
+
+	fields := map[string]interface{}{"summary": "Build failed"}
+	_, err = service.Create(ctx, IssueCreateRequest{
+		Fields:       fields,
+		CustomFields: map[string]interface{}{"Story Points": 3, "Sprint": 7},
+	}, IssueCreateOpts{})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	want := map[string]interface{}{"summary": "Build failed", "customfield_10016": float64(3), "customfield_10020": float64(7)}
+	if !reflect.DeepEqual(gotFields, want) {
+		t.Errorf("Create() fields = %v, want %v", gotFields, want)
+	}
+	if len(fields) != 1 {
+		t.Errorf("Create() modified the caller's fields: %v", fields)
+	}
+
+	err = service.Update(ctx, "TEST-1", IssueUpdateRequest{
+		CustomFields: map[string]interface{}{"story points": nil},
+	}, IssueUpdateOpts{})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if v, ok := gotFields["customfield_10016"]; !ok || v != nil {
+		t.Errorf("Update() fields = %v, want customfield_10016 cleared", gotFields)
+	}
+
+	err = service.Update(ctx, "TEST-1", IssueUpdateRequest{
+		CustomFields: map[string]interface{}{"Team": "Platform"},
+	}, IssueUpdateOpts{})
+	if !errors.Is(err, ErrAmbiguousField) {
+		t.Errorf("Update() error = %v, want ErrAmbiguousField", err)
+	}
+	err = service.Update(ctx, "TEST-1", IssueUpdateRequest{
+		Fields:       map[string]interface{}{"customfield_10016": 1},
+		CustomFields: map[string]interface{}{"Story Points": 2},
+	}, IssueUpdateOpts{})
+	if err == nil {
+		t.Error("Update() expected error for a field set twice, got nil")
+	}
+
+	// Once the registry is loaded, it is attached to fetched issues
+	issue, err = service.Get(ctx, "TEST-1", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if points, err := issue.CustomField("Story Points").Float(); err != nil || points != 5 {
+		t.Errorf("Float() = %v, %v, want 5", points, err)
+	}
+}
@@ -1,118 +1,158 @@
 package issue
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 	"net/url"
+	"strconv"
+	"sync/atomic"
 
 	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/rest"
 	"github.com/ducminhgd/go-atlassian/jira/v3/utils"
 )
 
 // Service handles communication with the issue related methods
 type Service struct {
-	client  *http.Client
-	baseURL string
-	auth    auth.Authenticator
+	client   *rest.Client
+	metadata *metadataCache
+
+	// The last field registry loaded by FieldRegistry, attached to returned issues
+	registry atomic.Pointer[FieldRegistry]
+
+	// Whether ADF documents are validated before write requests are sent
+	validateADF atomic.Bool
 }
 
 // NewService creates a new service instance
 func NewService(client *http.Client, baseURL string, auth auth.Authenticator) *Service {
-	if client == nil {
-		client = http.DefaultClient
-	}
+	return NewServiceWithClient(rest.NewClient(client, baseURL, auth))
+}
+
+// NewServiceWithClient creates a new service instance on top of a shared request pipeline
+func NewServiceWithClient(client *rest.Client) *Service {
 	return &Service{
-		client:  client,
-		baseURL: baseURL,
-		auth:    auth,
+		client:   client,
+		metadata: newMetadataCache(METADATA_CACHE_TTL),
 	}
 }
 
-// newRequest creates a new HTTP request
-func (s *Service) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
-	u, err := url.Parse(s.baseURL + path)
-	if err != nil {
+// SearchJQL searches for issues using JQL (Jira Query Language)
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-search/#api-rest-api-3-search-jql-post
+func (s *Service) SearchJQL(ctx context.Context, request JQLSearchRequest) (*JQLSearchResponse, error) {
+	response := new(JQLSearchResponse)
+	if err := s.searchJQL(ctx, request, response); err != nil {
 		return nil, err
 	}
+	s.attachFieldRegistry(response.Issues)
 
-	var buf io.ReadWriter
-	if body != nil {
-		buf = new(bytes.Buffer)
-		enc := json.NewEncoder(buf)
-		enc.SetEscapeHTML(false)
-		err := enc.Encode(body)
-		if err != nil {
-			return nil, err
-		}
-	}
+	return response, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
-	if err != nil {
-		return nil, err
+// searchJQL validates the search request and decodes the page of results into v
+func (s *Service) searchJQL(ctx context.Context, request JQLSearchRequest, v interface{}) error {
+	if request.JQL == "" {
+		return fmt.Errorf("JQL query is required")
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	// Set default max results if not specified
+	if request.MaxResults <= 0 || request.MaxResults > utils.MAX_RESULTS {
+		request.MaxResults = utils.MAX_RESULTS_DEFAULT
 	}
-	req.Header.Set("Accept", "application/json")
 
-	err = s.auth.AddAuthentication(req)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, ISSUE_SEARCH_JQL_ENDPOINT, request)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("error creating request: %w", err)
 	}
 
-	return req, nil
-}
-
-// do sends an HTTP request and returns the response
-func (s *Service) do(req *http.Request, v interface{}) error {
-	resp, err := s.client.Do(req)
-	if err != nil {
-		return err
+	if err := s.client.Do(req, v); err != nil {
+		return fmt.Errorf("error making request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
-	}
+	return nil
+}
 
-	if v != nil {
-		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-			return err
+// SearchJQLAll searches for issues using JQL and follows nextPageToken until the last page.
+// When maxIssues is greater than zero, at most maxIssues issues are returned.
+func (s *Service) SearchJQLAll(ctx context.Context, request JQLSearchRequest, maxIssues int) ([]Issue, error) {
+	var issues []Issue
+	for issue, err := range s.SearchJQLIter(ctx, request, maxIssues) {
+		if err != nil {
+			return issues, err
 		}
+		issues = append(issues, issue)
 	}
+	return issues, nil
+}
 
-	return nil
+// SearchJQLIter returns an iterator over every issue matching the JQL query.
+// Pages are fetched lazily by following nextPageToken until isLast. The iteration stops
+// after yielding an error, including the context error when ctx is cancelled.
+// When maxIssues is greater than zero, at most maxIssues issues are yielded.
+func (s *Service) SearchJQLIter(ctx context.Context, request JQLSearchRequest, maxIssues int) iter.Seq2[Issue, error] {
+	return searchJQLPages(ctx, request, maxIssues, func(ctx context.Context, request JQLSearchRequest) (jqlSearchPage[Issue], error) {
+		page, err := s.SearchJQL(ctx, request)
+		if err != nil {
+			return jqlSearchPage[Issue]{}, err
+		}
+		return jqlSearchPage[Issue]{IsLast: page.IsLast, NextPageToken: page.NextPageToken, Issues: page.Issues}, nil
+	})
 }
 
-// SearchJQL searches for issues using JQL (Jira Query Language)
-// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-search/#api-rest-api-3-search-jql-post
-func (s *Service) SearchJQL(ctx context.Context, request JQLSearchRequest) (*JQLSearchResponse, error) {
-	if request.JQL == "" {
-		return nil, fmt.Errorf("JQL query is required")
-	}
+// jqlSearchPage is a page of search results holding issues decoded into I
+type jqlSearchPage[I any] struct {
+	IsLast        bool   `json:"isLast"`
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	Issues        []I    `json:"issues"`
+}
 
-	// Set default max results if not specified
-	if request.MaxResults <= 0 || request.MaxResults > utils.MAX_RESULTS {
-		request.MaxResults = utils.MAX_RESULTS_DEFAULT
-	}
+// searchJQLPages returns an iterator over the issues of the pages returned by fetch,
+// following nextPageToken until isLast
+func searchJQLPages[I any](ctx context.Context, request JQLSearchRequest, maxIssues int, fetch func(context.Context, JQLSearchRequest) (jqlSearchPage[I], error)) iter.Seq2[I, error] {
+	var zero I
+	return func(yield func(I, error) bool) {
+		pageSize := request.MaxResults
+		if pageSize <= 0 || pageSize > utils.MAX_RESULTS {
+			pageSize = utils.MAX_RESULTS_DEFAULT
+		}
 
-	req, err := s.newRequest(ctx, http.MethodPost, ISSUE_SEARCH_JQL_ENDPOINT, request)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
-	}
+		count := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
 
-	response := new(JQLSearchResponse)
-	if err := s.do(req, response); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
-	}
+			request.MaxResults = pageSize
+			if maxIssues > 0 {
+				request.MaxResults = min(pageSize, maxIssues-count)
+			}
 
-	return response, nil
+			page, err := fetch(ctx, request)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, issue := range page.Issues {
+				if !yield(issue, nil) {
+					return
+				}
+				count++
+				if maxIssues > 0 && count >= maxIssues {
+					return
+				}
+			}
+
+			// Stop on the last page, and guard against a server that never advances the cursor
+			if page.IsLast || page.NextPageToken == "" || page.NextPageToken == request.NextPageToken {
+				return
+			}
+			request.NextPageToken = page.NextPageToken
+		}
+	}
 }
 
 // Get retrieves an issue by its ID or key
@@ -122,6 +162,17 @@ func (s *Service) Get(ctx context.Context, issueIDOrKey string, expand []string,
 		return nil, fmt.Errorf("issue ID or key is required")
 	}
 
+	issue := new(Issue)
+	if err := s.getIssue(ctx, issueIDOrKey, expand, fields, properties, issue); err != nil {
+		return nil, err
+	}
+	issue.registry = s.registry.Load()
+
+	return issue, nil
+}
+
+// getIssue retrieves an issue and decodes it into v
+func (s *Service) getIssue(ctx context.Context, issueIDOrKey string, expand []string, fields []string, properties []string, v interface{}) error {
 	path := fmt.Sprintf(ISSUE_GET_ENDPOINT, issueIDOrKey)
 	params := url.Values{}
 
@@ -145,15 +196,124 @@ func (s *Service) Get(ctx context.Context, issueIDOrKey string, expand []string,
 		path = fmt.Sprintf("%s?%s", path, params.Encode())
 	}
 
-	req, err := s.newRequest(ctx, http.MethodGet, path, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return fmt.Errorf("error creating request: %w", err)
 	}
 
-	issue := new(Issue)
-	if err := s.do(req, issue); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, v); err != nil {
+		return fmt.Errorf("error making request: %w", err)
 	}
 
-	return issue, nil
+	return nil
+}
+
+// Create creates an issue or, when the issue type is a subtask, a subtask.
+// Fields set by name through CustomFields are resolved with the field registry.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-post
+func (s *Service) Create(ctx context.Context, request IssueCreateRequest, opts IssueCreateOpts) (*CreatedIssue, error) {
+	if len(request.Fields) == 0 && len(request.Update) == 0 && len(request.CustomFields) == 0 {
+		return nil, fmt.Errorf("issue fields are required")
+	}
+
+	fields, err := s.resolveCustomFields(ctx, request.Fields, request.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	request.Fields = fields
+
+	if err := s.checkADF(request); err != nil {
+		return nil, err
+	}
+
+	path := ISSUE_CREATE_ENDPOINT
+	if opts.UpdateHistory {
+		path = fmt.Sprintf("%s?updateHistory=true", path)
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	created := new(CreatedIssue)
+	if err := s.client.Do(req, created); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return created, nil
+}
+
+// Update edits an issue, setting values through fields and applying operations through update.
+// Fields set by name through CustomFields are resolved with the field registry.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-put
+func (s *Service) Update(ctx context.Context, issueIDOrKey string, request IssueUpdateRequest, opts IssueUpdateOpts) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+	if len(request.Fields) == 0 && len(request.Update) == 0 && len(request.CustomFields) == 0 {
+		return fmt.Errorf("issue fields or update operations are required")
+	}
+
+	fields, err := s.resolveCustomFields(ctx, request.Fields, request.CustomFields)
+	if err != nil {
+		return err
+	}
+	request.Fields = fields
+
+	if err := s.checkADF(request); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf(ISSUE_UPDATE_ENDPOINT, issueIDOrKey)
+	params := url.Values{}
+
+	if opts.NotifyUsers != nil {
+		params.Add("notifyUsers", strconv.FormatBool(*opts.NotifyUsers))
+	}
+	if opts.OverrideScreenSecurity {
+		params.Add("overrideScreenSecurity", "true")
+	}
+	if opts.OverrideEditableFields {
+		params.Add("overrideEditableFields", "true")
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, request)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes an issue. Issues with subtasks can only be deleted together with their subtasks.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-delete
+func (s *Service) Delete(ctx context.Context, issueIDOrKey string, opts IssueDeleteOpts) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_DELETE_ENDPOINT, issueIDOrKey)
+	if opts.DeleteSubtasks {
+		path = fmt.Sprintf("%s?deleteSubtasks=true", path)
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
 }
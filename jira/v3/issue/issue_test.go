@@ -3,11 +3,16 @@ package issue
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/rest"
 	"github.com/ducminhgd/go-atlassian/jira/v3/utils"
 )
 
@@ -283,3 +288,417 @@ func TestService_Get_EmptyIssueKey(t *testing.T) {
 		t.Errorf("Expected 'issue ID or key is required' error, got '%s'", err.Error())
 	}
 }
+
+func TestService_Get_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"errorMessages": []string{"Issue does not exist or you do not have permission to see it."},
+			"errors":        map[string]string{},
+		})
+	}))
+	defer server.Close()
+
+	auth := auth.NewBasicAuth("test", "test")
+	service := NewService(nil, server.URL, auth)
+
+	_, err := service.Get(context.Background(), "TEST-404", nil, nil, nil)
+	if err == nil {
+		t.Fatal("Expected error for missing issue, got nil")
+	}
+	if !rest.IsNotFound(err) {
+		t.Errorf("Expected IsNotFound to be true, got false for %v", err)
+	}
+	if rest.IsUnauthorized(err) {
+		t.Errorf("Expected IsUnauthorized to be false for %v", err)
+	}
+}
+
+// newPagedSearchServer returns a server serving the given pages of issue keys, linked by nextPageToken
+func newPagedSearchServer(t *testing.T, pages [][]string, requests *[]JQLSearchRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request JQLSearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		*requests = append(*requests, request)
+
+		page := 0
+		if request.NextPageToken != "" {
+			fmt.Sscanf(request.NextPageToken, "page-%d", &page)
+		}
+
+		response := JQLSearchResponse{IsLast: page == len(pages)-1}
+		for _, key := range pages[page] {
+			response.Issues = append(response.Issues, Issue{Key: key})
+		}
+		if !response.IsLast {
+			response.NextPageToken = fmt.Sprintf("page-%d", page+1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestService_SearchJQLAll(t *testing.T) {
+	pages := [][]string{{"TEST-1", "TEST-2"}, {"TEST-3", "TEST-4"}, {"TEST-5"}}
+
+	tests := []struct {
+		name         string
+		maxIssues    int
+		wantKeys     []string
+		wantRequests int
+	}{
+		{
+			name:         "all pages",
+			maxIssues:    0,
+			wantKeys:     []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5"},
+			wantRequests: 3,
+		},
+		{
+			name:         "limited",
+			maxIssues:    3,
+			wantKeys:     []string{"TEST-1", "TEST-2", "TEST-3"},
+			wantRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []JQLSearchRequest
+			server := newPagedSearchServer(t, pages, &requests)
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			issues, err := service.SearchJQLAll(context.Background(), JQLSearchRequest{JQL: "project = TEST", MaxResults: 2}, tt.maxIssues)
+			if err != nil {
+				t.Fatalf("SearchJQLAll failed: %v", err)
+			}
+
+			var keys []string
+			for _, issue := range issues {
+				keys = append(keys, issue.Key)
+			}
+			if fmt.Sprint(keys) != fmt.Sprint(tt.wantKeys) {
+				t.Errorf("Expected keys %v, got %v", tt.wantKeys, keys)
+			}
+			if len(requests) != tt.wantRequests {
+				t.Errorf("Expected %d requests, got %d", tt.wantRequests, len(requests))
+			}
+			if requests[0].NextPageToken != "" {
+				t.Errorf("Expected first request without token, got '%s'", requests[0].NextPageToken)
+			}
+			if len(requests) > 1 && requests[1].NextPageToken != "page-1" {
+				t.Errorf("Expected second request with token 'page-1', got '%s'", requests[1].NextPageToken)
+			}
+			if tt.maxIssues > 0 && requests[len(requests)-1].MaxResults != 1 {
+				t.Errorf("Expected last page size to shrink to 1, got %d", requests[len(requests)-1].MaxResults)
+			}
+		})
+	}
+}
+
+func TestService_SearchJQLIter_Break(t *testing.T) {
+	var requests []JQLSearchRequest
+	server := newPagedSearchServer(t, [][]string{{"TEST-1", "TEST-2"}, {"TEST-3"}}, &requests)
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	for issue, err := range service.SearchJQLIter(context.Background(), JQLSearchRequest{JQL: "project = TEST"}, 0) {
+		if err != nil {
+			t.Fatalf("SearchJQLIter failed: %v", err)
+		}
+		if issue.Key == "TEST-1" {
+			break
+		}
+	}
+
+	if len(requests) != 1 {
+		t.Errorf("Expected 1 request after break, got %d", len(requests))
+	}
+}
+
+func TestService_SearchJQLIter_ContextCancelled(t *testing.T) {
+	var requests []JQLSearchRequest
+	server := newPagedSearchServer(t, [][]string{{"TEST-1"}, {"TEST-2"}}, &requests)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+
+	var keys []string
+	var gotErr error
+	for issue, err := range service.SearchJQLIter(ctx, JQLSearchRequest{JQL: "project = TEST"}, 0) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		keys = append(keys, issue.Key)
+		cancel()
+	}
+
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", gotErr)
+	}
+	if len(keys) != 1 || len(requests) != 1 {
+		t.Errorf("Expected to stop after the first page, got keys %v and %d requests", keys, len(requests))
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    IssueCreateRequest
+		opts       IssueCreateOpts
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name: "success",
+			request: IssueCreateRequest{
+				Fields: map[string]interface{}{
+					"project":   map[string]string{"key": "TEST"},
+					"issuetype": map[string]string{"name": "Bug"},
+					"summary":   "Build failed",
+				},
+			},
+			wantURL:    "/rest/api/3/issue",
+			statusCode: http.StatusCreated,
+		},
+		{
+			name: "success - update history",
+			request: IssueCreateRequest{
+				Fields: map[string]interface{}{"summary": "Build failed"},
+			},
+			opts:       IssueCreateOpts{UpdateHistory: true},
+			wantURL:    "/rest/api/3/issue?updateHistory=true",
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:    "error - empty request",
+			request: IssueCreateRequest{},
+			wantErr: true,
+		},
+		{
+			name: "error - missing summary",
+			request: IssueCreateRequest{
+				Fields: map[string]interface{}{"project": map[string]string{"key": "TEST"}},
+			},
+			wantURL:    "/rest/api/3/issue",
+			wantErr:    true,
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want POST", r.Method)
+				}
+
+				var body map[string]map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("Failed to decode request body: %v", err)
+				}
+				for k := range tt.request.Fields {
+					if _, ok := body["fields"][k]; !ok {
+						t.Errorf("Body missing field %q", k)
+					}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode >= 400 {
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"errors": map[string]string{"summary": "You must specify a summary of the issue."},
+					})
+					return
+				}
+				json.NewEncoder(w).Encode(CreatedIssue{ID: "10001", Key: "TEST-1", Self: "https://example.atlassian.net/rest/api/3/issue/10001"})
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			created, err := service.Create(context.Background(), tt.request, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (created.Key != "TEST-1" || created.ID != "10001") {
+				t.Errorf("Create() = %+v, want TEST-1/10001", created)
+			}
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		issueKey   string
+		request    IssueUpdateRequest
+		opts       IssueUpdateOpts
+		wantURL    string
+		wantBody   string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:     "success - fields",
+			issueKey: "TEST-1",
+			request: IssueUpdateRequest{
+				Fields: map[string]interface{}{"summary": "New summary"},
+			},
+			wantURL:    "/rest/api/3/issue/TEST-1",
+			wantBody:   `{"fields":{"summary":"New summary"}}`,
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:     "success - update operations and options",
+			issueKey: "TEST-1",
+			request: IssueUpdateRequest{
+				Update: map[string][]FieldOperation{
+					"labels": {{Add: "release"}, {Remove: "triage"}},
+				},
+			},
+			opts: IssueUpdateOpts{
+				NotifyUsers:            utils.Bool(false),
+				OverrideScreenSecurity: true,
+				OverrideEditableFields: true,
+			},
+			wantURL:    "/rest/api/3/issue/TEST-1?notifyUsers=false&overrideEditableFields=true&overrideScreenSecurity=true",
+			wantBody:   `{"update":{"labels":[{"add":"release"},{"remove":"triage"}]}}`,
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:     "success - clear field",
+			issueKey: "TEST-1",
+			request: IssueUpdateRequest{
+				Fields: map[string]interface{}{"assignee": nil},
+			},
+			wantURL:    "/rest/api/3/issue/TEST-1",
+			wantBody:   `{"fields":{"assignee":null}}`,
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:    "error - missing key",
+			request: IssueUpdateRequest{Fields: map[string]interface{}{"summary": "x"}},
+			wantErr: true,
+		},
+		{
+			name:     "error - empty request",
+			issueKey: "TEST-1",
+			wantErr:  true,
+		},
+		{
+			name:       "error - forbidden",
+			issueKey:   "TEST-1",
+			request:    IssueUpdateRequest{Fields: map[string]interface{}{"summary": "x"}},
+			wantURL:    "/rest/api/3/issue/TEST-1",
+			wantErr:    true,
+			statusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				if r.Method != http.MethodPut {
+					t.Errorf("Method = %v, want PUT", r.Method)
+				}
+				if tt.wantBody != "" {
+					body, _ := io.ReadAll(r.Body)
+					if strings.TrimSpace(string(body)) != tt.wantBody {
+						t.Errorf("Body = %s, want %s", body, tt.wantBody)
+					}
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			err := service.Update(context.Background(), tt.issueKey, tt.request, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		issueKey   string
+		opts       IssueDeleteOpts
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "success",
+			issueKey:   "TEST-1",
+			wantURL:    "/rest/api/3/issue/TEST-1",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "success - with subtasks",
+			issueKey:   "TEST-1",
+			opts:       IssueDeleteOpts{DeleteSubtasks: true},
+			wantURL:    "/rest/api/3/issue/TEST-1?deleteSubtasks=true",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "error - has subtasks",
+			issueKey:   "TEST-1",
+			wantURL:    "/rest/api/3/issue/TEST-1",
+			wantErr:    true,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "error - missing key",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				if r.Method != http.MethodDelete {
+					t.Errorf("Method = %v, want DELETE", r.Method)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			err := service.Delete(context.Background(), tt.issueKey, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
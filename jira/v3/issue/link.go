@@ -0,0 +1,86 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateLink links two issues. With the "Blocks" type, request.InwardIssue blocks request.OutwardIssue.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-links/#api-rest-api-3-issuelink-post
+func (s *Service) CreateLink(ctx context.Context, request IssueLinkRequest) error {
+	if request.Type.ID == "" && request.Type.Name == "" {
+		return fmt.Errorf("issue link type is required")
+	}
+	if request.InwardIssue == (IssueReference{}) || request.OutwardIssue == (IssueReference{}) {
+		return fmt.Errorf("inward and outward issues are required")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, ISSUE_LINKS_ENDPOINT, request)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// GetLink retrieves an issue link by its ID
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-links/#api-rest-api-3-issuelink-linkid-get
+func (s *Service) GetLink(ctx context.Context, linkID string) (*IssueLink, error) {
+	if linkID == "" {
+		return nil, fmt.Errorf("issue link ID is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_LINK_DETAIL_ENDPOINT, linkID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	link := new(IssueLink)
+	if err := s.client.Do(req, link); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return link, nil
+}
+
+// DeleteLink deletes an issue link
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-links/#api-rest-api-3-issuelink-linkid-delete
+func (s *Service) DeleteLink(ctx context.Context, linkID string) error {
+	if linkID == "" {
+		return fmt.Errorf("issue link ID is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_LINK_DETAIL_ENDPOINT, linkID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// ListLinkTypes retrieves every issue link type
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-link-types/#api-rest-api-3-issuelinktype-get
+func (s *Service) ListLinkTypes(ctx context.Context) ([]IssueLinkType, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, ISSUE_LINK_TYPES_ENDPOINT, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	types := new(IssueLinkTypes)
+	if err := s.client.Do(req, types); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return types.IssueLinkTypes, nil
+}
@@ -0,0 +1,216 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// linkWalkBatchSize is the number of issues fetched per search while walking links
+const linkWalkBatchSize = 100
+
+// linkWalkFields are the fields fetched for every issue reached by a link walk
+var linkWalkFields = []string{"issuelinks", "summary", "status", "issuetype"}
+
+// LinkGraph represents the issues reachable from a root issue through links
+type LinkGraph struct {
+	// The key of the root issue
+	Root string
+
+	// The issues in the graph in breadth-first order, starting with the root
+	Nodes []LinkNode
+
+	// The links between the issues in the graph
+	Edges []LinkEdge
+
+	// The cycles found in the graph, each listed as the keys along the cycle
+	Cycles [][]string
+}
+
+// LinkNode represents an issue in a LinkGraph
+type LinkNode struct {
+	ID        string
+	Key       string
+	Summary   string
+	Status    StatusDetails
+	IssueType IssueType
+
+	// Number of links between the root and this issue
+	Depth int
+}
+
+// LinkEdge represents a link in a LinkGraph, read as "From <outward description> To", e.g. "From blocks To"
+type LinkEdge struct {
+	From string
+	To   string
+
+	// The name of the link type
+	Type string
+}
+
+// Node returns the issue with the given key
+func (g *LinkGraph) Node(key string) (LinkNode, bool) {
+	for _, node := range g.Nodes {
+		if node.Key == key {
+			return node, true
+		}
+	}
+	return LinkNode{}, false
+}
+
+// WalkLinks expands the links of an issue breadth-first, following only the configured link types and direction.
+// Every issue is expanded once, so cycles do not loop forever and are reported in LinkGraph.Cycles.
+// By default, it collects the full chain of issues blocking the root.
+// When a search fails midway, the graph built so far is returned along with the error.
+func (s *Service) WalkLinks(ctx context.Context, issueIDOrKey string, opts IssueLinkWalkOpts) (*LinkGraph, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	linkTypes := opts.LinkTypes
+	if len(linkTypes) == 0 {
+		linkTypes = []string{ISSUE_LINK_TYPE_BLOCKS}
+	}
+	direction := opts.Direction
+	if direction == "" {
+		direction = LINK_DIRECTION_INWARD
+	}
+	if direction != LINK_DIRECTION_INWARD && direction != LINK_DIRECTION_OUTWARD && direction != LINK_DIRECTION_BOTH {
+		return nil, fmt.Errorf("invalid link direction %q", direction)
+	}
+
+	graph := new(LinkGraph)
+	nodes := map[string]bool{}
+	edges := map[LinkEdge]bool{}
+
+	frontier := []string{issueIDOrKey}
+	for depth := 0; len(frontier) > 0; depth++ {
+		issues, err := s.fetchLinkedIssues(ctx, frontier)
+		if err != nil {
+			return graph, err
+		}
+
+		if depth == 0 {
+			if len(issues) == 0 {
+				return nil, fmt.Errorf("issue %s not found", issueIDOrKey)
+			}
+			root := issues[0]
+			graph.Root = root.Key
+			nodes[root.Key] = true
+			graph.Nodes = append(graph.Nodes, LinkNode{
+				ID:        root.ID,
+				Key:       root.Key,
+				Summary:   root.Fields.Summary,
+				Status:    root.Fields.Status,
+				IssueType: root.Fields.IssueType,
+			})
+		}
+
+		var next []string
+		for _, issue := range issues {
+			for _, link := range issue.Fields.IssueLinks {
+				if !slices.ContainsFunc(linkTypes, func(name string) bool { return strings.EqualFold(name, link.Type.Name) }) {
+					continue
+				}
+
+				var other *LinkedIssue
+				var edge LinkEdge
+				switch {
+				case link.OutwardIssue != nil && direction != LINK_DIRECTION_INWARD:
+					other = link.OutwardIssue
+					edge = LinkEdge{From: issue.Key, To: other.Key, Type: link.Type.Name}
+				case link.InwardIssue != nil && direction != LINK_DIRECTION_OUTWARD:
+					other = link.InwardIssue
+					edge = LinkEdge{From: other.Key, To: issue.Key, Type: link.Type.Name}
+				default:
+					continue
+				}
+
+				if !edges[edge] {
+					edges[edge] = true
+					graph.Edges = append(graph.Edges, edge)
+				}
+
+				if nodes[other.Key] {
+					continue
+				}
+				nodes[other.Key] = true
+				graph.Nodes = append(graph.Nodes, LinkNode{
+					ID:        other.ID,
+					Key:       other.Key,
+					Summary:   other.Fields.Summary,
+					Status:    other.Fields.Status,
+					IssueType: other.Fields.IssueType,
+					Depth:     depth + 1,
+				})
+				if opts.MaxDepth <= 0 || depth+1 < opts.MaxDepth {
+					next = append(next, other.Key)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	graph.Cycles = findLinkCycles(graph)
+	return graph, nil
+}
+
+// fetchLinkedIssues retrieves the links of the given issues, batching them into JQL searches
+func (s *Service) fetchLinkedIssues(ctx context.Context, keys []string) ([]Issue, error) {
+	var issues []Issue
+	for batch := range slices.Chunk(keys, linkWalkBatchSize) {
+		found, err := s.SearchJQLAll(ctx, JQLSearchRequest{
+			JQL:        fmt.Sprintf("issue in (%s)", strings.Join(batch, ", ")),
+			Fields:     linkWalkFields,
+			MaxResults: len(batch),
+		}, 0)
+		if err != nil {
+			return issues, err
+		}
+		issues = append(issues, found...)
+	}
+	return issues, nil
+}
+
+// findLinkCycles returns one cycle per back edge found by a depth-first search over the graph
+func findLinkCycles(graph *LinkGraph) [][]string {
+	adjacency := map[string][]string{}
+	for _, edge := range graph.Edges {
+		adjacency[edge.From] = append(adjacency[edge.From], edge.To)
+	}
+
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := map[string]int{}
+	var path []string
+	var cycles [][]string
+
+	var visit func(key string)
+	visit = func(key string) {
+		state[key] = inProgress
+		path = append(path, key)
+		for _, next := range adjacency[key] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case inProgress:
+				start := slices.Index(path, next)
+				cycles = append(cycles, slices.Clone(path[start:]))
+			}
+		}
+		path = path[:len(path)-1]
+		state[key] = done
+	}
+
+	for _, node := range graph.Nodes {
+		if state[node.Key] == unvisited {
+			visit(node.Key)
+		}
+	}
+
+	return cycles
+}
@@ -0,0 +1,168 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+// newLinkGraphServer serves JQL searches over issues linked by the given [inward, type, outward] triples
+func newLinkGraphServer(t *testing.T, links [][3]string, searches *int) *httptest.Server {
+	issueLinks := map[string][]IssueLink{}
+	for _, l := range links {
+		linkType := IssueLinkType{Name: l[1]}
+		issueLinks[l[0]] = append(issueLinks[l[0]], IssueLink{Type: linkType, OutwardIssue: &LinkedIssue{Key: l[2]}})
+		issueLinks[l[2]] = append(issueLinks[l[2]], IssueLink{Type: linkType, InwardIssue: &LinkedIssue{Key: l[0]}})
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*searches++
+
+		var request JQLSearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		keys := strings.TrimSuffix(strings.TrimPrefix(request.JQL, "issue in ("), ")")
+
+		response := JQLSearchResponse{IsLast: true}
+		for _, key := range strings.Split(keys, ", ") {
+			if _, ok := issueLinks[key]; !ok {
+				continue
+			}
+			issue := Issue{Key: key}
+			issue.Fields.IssueLinks = issueLinks[key]
+			response.Issues = append(response.Issues, issue)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestService_WalkLinks(t *testing.T) {
+	// REL-1 is blocked by A-1 and A-2, A-1 is blocked by B-1, and B-1 is blocked by REL-1
+	links := [][3]string{
+		{"A-1", "Blocks", "REL-1"},
+		{"A-2", "Blocks", "REL-1"},
+		{"B-1", "Blocks", "A-1"},
+		{"REL-1", "Blocks", "B-1"},
+		{"REL-1", "Blocks", "D-1"},
+		{"C-1", "Relates", "REL-1"},
+	}
+
+	tests := []struct {
+		name         string
+		opts         IssueLinkWalkOpts
+		wantNodes    []string
+		wantDepths   []int
+		wantEdges    int
+		wantCycles   [][]string
+		wantSearches int
+		wantErr      bool
+	}{
+		{
+			name:         "blocking chain",
+			wantNodes:    []string{"REL-1", "A-1", "A-2", "B-1"},
+			wantDepths:   []int{0, 1, 1, 2},
+			wantEdges:    4,
+			wantCycles:   [][]string{{"REL-1", "B-1", "A-1"}},
+			wantSearches: 3,
+		},
+		{
+			name:         "depth limit",
+			opts:         IssueLinkWalkOpts{MaxDepth: 1},
+			wantNodes:    []string{"REL-1", "A-1", "A-2"},
+			wantDepths:   []int{0, 1, 1},
+			wantEdges:    2,
+			wantSearches: 1,
+		},
+		{
+			name:         "outward",
+			opts:         IssueLinkWalkOpts{Direction: LINK_DIRECTION_OUTWARD},
+			wantNodes:    []string{"REL-1", "B-1", "D-1", "A-1"},
+			wantDepths:   []int{0, 1, 1, 2},
+			wantEdges:    4,
+			wantCycles:   [][]string{{"REL-1", "B-1", "A-1"}},
+			wantSearches: 3,
+		},
+		{
+			name:         "other link types",
+			opts:         IssueLinkWalkOpts{LinkTypes: []string{"relates"}, Direction: LINK_DIRECTION_BOTH},
+			wantNodes:    []string{"REL-1", "C-1"},
+			wantDepths:   []int{0, 1},
+			wantEdges:    1,
+			wantSearches: 2,
+		},
+		{
+			name:    "invalid direction",
+			opts:    IssueLinkWalkOpts{Direction: "sideways"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var searches int
+			server := newLinkGraphServer(t, links, &searches)
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			graph, err := service.WalkLinks(context.Background(), "REL-1", tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WalkLinks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var nodes []string
+			var depths []int
+			for _, node := range graph.Nodes {
+				nodes = append(nodes, node.Key)
+				depths = append(depths, node.Depth)
+			}
+			if !reflect.DeepEqual(nodes, tt.wantNodes) || !reflect.DeepEqual(depths, tt.wantDepths) {
+				t.Errorf("Nodes = %v %v, want %v %v", nodes, depths, tt.wantNodes, tt.wantDepths)
+			}
+			if len(graph.Edges) != tt.wantEdges {
+				t.Errorf("Edges = %v, want %d edges", graph.Edges, tt.wantEdges)
+			}
+			if !reflect.DeepEqual(graph.Cycles, tt.wantCycles) {
+				t.Errorf("Cycles = %v, want %v", graph.Cycles, tt.wantCycles)
+			}
+			if searches != tt.wantSearches {
+				t.Errorf("searches = %d, want %d", searches, tt.wantSearches)
+			}
+		})
+	}
+}
+
+func TestService_WalkLinks_Edges(t *testing.T) {
+	var searches int
+	server := newLinkGraphServer(t, [][3]string{{"A-1", "Blocks", "REL-1"}}, &searches)
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	graph, err := service.WalkLinks(context.Background(), "REL-1", IssueLinkWalkOpts{})
+	if err != nil {
+		t.Fatalf("WalkLinks() error = %v", err)
+	}
+
+	want := []LinkEdge{{From: "A-1", To: "REL-1", Type: "Blocks"}}
+	if !reflect.DeepEqual(graph.Edges, want) {
+		t.Errorf("Edges = %v, want %v", graph.Edges, want)
+	}
+	if node, ok := graph.Node("A-1"); !ok || node.Depth != 1 {
+		t.Errorf("Node(A-1) = %+v, %v", node, ok)
+	}
+
+	if _, err := service.WalkLinks(context.Background(), "MISSING-1", IssueLinkWalkOpts{}); err == nil {
+		t.Error("WalkLinks() expected error for missing root, got nil")
+	}
+}
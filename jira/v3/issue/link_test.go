@@ -0,0 +1,159 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+func TestService_CreateLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  IssueLinkRequest
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name: "success",
+			request: IssueLinkRequest{
+				Type:         IssueLinkTypeReference{Name: ISSUE_LINK_TYPE_BLOCKS},
+				InwardIssue:  IssueReference{Key: "TEST-1"},
+				OutwardIssue: IssueReference{Key: "TEST-2"},
+			},
+			wantBody: `{"type":{"name":"Blocks"},"inwardIssue":{"key":"TEST-1"},"outwardIssue":{"key":"TEST-2"}}`,
+		},
+		{
+			name: "success - with comment",
+			request: IssueLinkRequest{
+				Type:         IssueLinkTypeReference{ID: "10000"},
+				InwardIssue:  IssueReference{ID: "10001"},
+				OutwardIssue: IssueReference{ID: "10002"},
+				Comment:      &CommentRequest{Body: "Linked", Visibility: &Visibility{Type: VISIBILITY_TYPE_GROUP, Value: "jira-developers"}},
+			},
+			wantBody: `{"type":{"id":"10000"},"inwardIssue":{"id":"10001"},"outwardIssue":{"id":"10002"},"comment":{"body":"Linked","visibility":{"type":"group","value":"jira-developers"}}}`,
+		},
+		{
+			name: "error - missing type",
+			request: IssueLinkRequest{
+				InwardIssue:  IssueReference{Key: "TEST-1"},
+				OutwardIssue: IssueReference{Key: "TEST-2"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error - missing outward issue",
+			request: IssueLinkRequest{
+				Type:        IssueLinkTypeReference{Name: ISSUE_LINK_TYPE_BLOCKS},
+				InwardIssue: IssueReference{Key: "TEST-1"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issueLink" {
+					t.Errorf("Request = %v %v", r.Method, r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if strings.TrimSpace(string(body)) != tt.wantBody {
+					t.Errorf("Body = %s, want %s", body, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			err := service.CreateLink(context.Background(), tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateLink() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetDeleteLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issueLink/10001" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(IssueLink{
+				ID:           "10001",
+				Type:         IssueLinkType{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+				InwardIssue:  &LinkedIssue{Key: "TEST-1"},
+				OutwardIssue: &LinkedIssue{Key: "TEST-2"},
+			})
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	link, err := service.GetLink(ctx, "10001")
+	if err != nil {
+		t.Fatalf("GetLink() error = %v", err)
+	}
+	if link.InwardIssue.Key != "TEST-1" || link.OutwardIssue.Key != "TEST-2" || link.Type.Outward != "blocks" {
+		t.Errorf("GetLink() = %+v", link)
+	}
+	if _, err := service.GetLink(ctx, "404"); err == nil {
+		t.Error("GetLink() expected error for missing link, got nil")
+	}
+
+	if err := service.DeleteLink(ctx, "10001"); err != nil {
+		t.Errorf("DeleteLink() error = %v", err)
+	}
+	if err := service.DeleteLink(ctx, ""); err == nil {
+		t.Error("DeleteLink() expected error for missing ID, got nil")
+	}
+}
+
+func TestService_ListLinkTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issueLinkType" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issueLinkTypes":[{"id":"1000","name":"Blocks","inward":"is blocked by","outward":"blocks"},{"id":"1001","name":"Relates","inward":"relates to","outward":"relates to"}]}`))
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	types, err := service.ListLinkTypes(context.Background())
+	if err != nil {
+		t.Fatalf("ListLinkTypes() error = %v", err)
+	}
+	if len(types) != 2 || types[0].Inward != "is blocked by" {
+		t.Errorf("ListLinkTypes() = %+v", types)
+	}
+}
+
+func TestIssueFields_IssueLinks(t *testing.T) {
+	data := `{"key":"TEST-1","fields":{"issuelinks":[{"id":"10001","type":{"name":"Blocks","inward":"is blocked by","outward":"blocks"},"outwardIssue":{"key":"TEST-2","fields":{"summary":"Deploy","status":{"name":"To Do"}}}}]}}`
+
+	var issue Issue
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(issue.Fields.IssueLinks) != 1 {
+		t.Fatalf("IssueLinks = %+v, want 1 link", issue.Fields.IssueLinks)
+	}
+	link := issue.Fields.IssueLinks[0]
+	if link.InwardIssue != nil || link.OutwardIssue.Key != "TEST-2" || link.OutwardIssue.Fields.Status.Name != "To Do" {
+		t.Errorf("IssueLinks[0] = %+v", link)
+	}
+}
@@ -0,0 +1,222 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// ErrMetadataNotFound is returned when a name can't be resolved to an issue type, priority, status or field
+var ErrMetadataNotFound = errors.New("metadata not found")
+
+// SetMetadataCacheTTL changes how long issue types, priorities, statuses and fields are cached.
+// A zero or negative ttl disables caching. The default is METADATA_CACHE_TTL.
+func (s *Service) SetMetadataCacheTTL(ttl time.Duration) {
+	s.metadata.setTTL(ttl)
+}
+
+// ClearMetadataCache drops every cached issue type, priority, status, field and field registry,
+// e.g. after an administrator adds a new status
+func (s *Service) ClearMetadataCache() {
+	s.metadata.clear()
+	s.registry.Store(nil)
+}
+
+// ListIssueTypes retrieves every issue type the user can see
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-types/#api-rest-api-3-issuetype-get
+func (s *Service) ListIssueTypes(ctx context.Context) ([]responsetypes.IssueType, error) {
+	issueTypes, err := cachedMetadata(ctx, s.metadata, "issuetypes", func(ctx context.Context) ([]responsetypes.IssueType, error) {
+		var issueTypes []responsetypes.IssueType
+		return issueTypes, s.getMetadata(ctx, ISSUE_TYPES_ENDPOINT, &issueTypes)
+	})
+	return slices.Clone(issueTypes), err
+}
+
+// ListProjectIssueTypes retrieves the issue types of a project, given by its numeric ID
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-types/#api-rest-api-3-issuetype-project-get
+func (s *Service) ListProjectIssueTypes(ctx context.Context, projectID string) ([]responsetypes.IssueType, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+
+	issueTypes, err := cachedMetadata(ctx, s.metadata, "issuetypes:"+projectID, func(ctx context.Context) ([]responsetypes.IssueType, error) {
+		var issueTypes []responsetypes.IssueType
+		path := fmt.Sprintf("%s?projectId=%s", ISSUE_TYPES_PROJECT_ENDPOINT, url.QueryEscape(projectID))
+		return issueTypes, s.getMetadata(ctx, path, &issueTypes)
+	})
+	return slices.Clone(issueTypes), err
+}
+
+// GetIssueType retrieves an issue type by its ID
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-types/#api-rest-api-3-issuetype-id-get
+func (s *Service) GetIssueType(ctx context.Context, issueTypeID string) (*responsetypes.IssueType, error) {
+	if issueTypeID == "" {
+		return nil, fmt.Errorf("issue type ID is required")
+	}
+
+	issueType := new(responsetypes.IssueType)
+	if err := s.getMetadata(ctx, fmt.Sprintf(ISSUE_TYPE_DETAIL_ENDPOINT, issueTypeID), issueType); err != nil {
+		return nil, err
+	}
+	return issueType, nil
+}
+
+// IssueTypeID resolves an issue type name, e.g. "Bug", to its ID.
+// When projectID is set, only the issue types of that project are considered.
+func (s *Service) IssueTypeID(ctx context.Context, projectID, name string) (string, error) {
+	var issueTypes []responsetypes.IssueType
+	var err error
+	if projectID != "" {
+		issueTypes, err = s.ListProjectIssueTypes(ctx, projectID)
+	} else {
+		issueTypes, err = s.ListIssueTypes(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	for _, issueType := range issueTypes {
+		if issueType.ID == name || strings.EqualFold(issueType.Name, name) {
+			return issueType.ID, nil
+		}
+	}
+	return "", fmt.Errorf("issue type %q: %w", name, ErrMetadataNotFound)
+}
+
+// ListPriorities retrieves every issue priority
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-priorities/#api-rest-api-3-priority-get
+func (s *Service) ListPriorities(ctx context.Context) ([]responsetypes.Priority, error) {
+	priorities, err := cachedMetadata(ctx, s.metadata, "priorities", func(ctx context.Context) ([]responsetypes.Priority, error) {
+		var priorities []responsetypes.Priority
+		return priorities, s.getMetadata(ctx, ISSUE_PRIORITIES_ENDPOINT, &priorities)
+	})
+	return slices.Clone(priorities), err
+}
+
+// GetPriority retrieves an issue priority by its ID
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-priorities/#api-rest-api-3-priority-id-get
+func (s *Service) GetPriority(ctx context.Context, priorityID string) (*responsetypes.Priority, error) {
+	if priorityID == "" {
+		return nil, fmt.Errorf("priority ID is required")
+	}
+
+	priority := new(responsetypes.Priority)
+	if err := s.getMetadata(ctx, fmt.Sprintf(ISSUE_PRIORITY_DETAIL_ENDPOINT, priorityID), priority); err != nil {
+		return nil, err
+	}
+	return priority, nil
+}
+
+// PriorityID resolves a priority name, e.g. "High", to its ID
+func (s *Service) PriorityID(ctx context.Context, name string) (string, error) {
+	priorities, err := s.ListPriorities(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, priority := range priorities {
+		if priority.ID == name || strings.EqualFold(priority.Name, name) {
+			return priority.ID, nil
+		}
+	}
+	return "", fmt.Errorf("priority %q: %w", name, ErrMetadataNotFound)
+}
+
+// ListStatuses retrieves every status of the workflows the user can see
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflow-statuses/#api-rest-api-3-status-get
+func (s *Service) ListStatuses(ctx context.Context) ([]responsetypes.StatusDetails, error) {
+	statuses, err := cachedMetadata(ctx, s.metadata, "statuses", func(ctx context.Context) ([]responsetypes.StatusDetails, error) {
+		var statuses []responsetypes.StatusDetails
+		return statuses, s.getMetadata(ctx, ISSUE_STATUSES_ENDPOINT, &statuses)
+	})
+	return slices.Clone(statuses), err
+}
+
+// GetStatus retrieves a status by its ID or name
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflow-statuses/#api-rest-api-3-status-idorname-get
+func (s *Service) GetStatus(ctx context.Context, statusIDOrName string) (*responsetypes.StatusDetails, error) {
+	if statusIDOrName == "" {
+		return nil, fmt.Errorf("status ID or name is required")
+	}
+
+	status := new(responsetypes.StatusDetails)
+	if err := s.getMetadata(ctx, fmt.Sprintf(ISSUE_STATUS_DETAIL_ENDPOINT, url.PathEscape(statusIDOrName)), status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// StatusID resolves a status name, e.g. "In Review", to its ID
+func (s *Service) StatusID(ctx context.Context, name string) (string, error) {
+	statuses, err := s.ListStatuses(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, status := range statuses {
+		if status.ID == name || strings.EqualFold(status.Name, name) {
+			return status.ID, nil
+		}
+	}
+	return "", fmt.Errorf("status %q: %w", name, ErrMetadataNotFound)
+}
+
+// ListFields retrieves every system and custom field
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-fields/#api-rest-api-3-field-get
+func (s *Service) ListFields(ctx context.Context) ([]responsetypes.Field, error) {
+	fields, err := cachedMetadata(ctx, s.metadata, "fields", func(ctx context.Context) ([]responsetypes.Field, error) {
+		var fields []responsetypes.Field
+		return fields, s.getMetadata(ctx, ISSUE_FIELDS_ENDPOINT, &fields)
+	})
+	return slices.Clone(fields), err
+}
+
+// GetField retrieves a field by its ID or display name. Jira has no endpoint for a single field,
+// so it is looked up in the cached field list. When several fields share the name,
+// ErrAmbiguousField is returned and the field has to be referenced by ID.
+func (s *Service) GetField(ctx context.Context, fieldIDOrName string) (*responsetypes.Field, error) {
+	if fieldIDOrName == "" {
+		return nil, fmt.Errorf("field ID or name is required")
+	}
+
+	fields, err := s.ListFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	field, err := NewFieldRegistry(fields).Field(fieldIDOrName)
+	if err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
+// FieldID resolves a field display name, e.g. "Story Points", to its ID, e.g. "customfield_10016"
+func (s *Service) FieldID(ctx context.Context, name string) (string, error) {
+	field, err := s.GetField(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return field.ID, nil
+}
+
+// getMetadata sends a GET request and decodes the response into v
+func (s *Service) getMetadata(ctx context.Context, path string, v interface{}) error {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, v); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package issue
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// metadataCache keeps metadata lists in memory for a limited time.
+// Concurrent misses may fetch the same list more than once, the last result wins.
+type metadataCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]metadataEntry
+	now     func() time.Time
+}
+
+// metadataEntry is a cached value and the time it expires
+type metadataEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// newMetadataCache creates a cache keeping entries for ttl
+func newMetadataCache(ttl time.Duration) *metadataCache {
+	return &metadataCache{
+		ttl:     ttl,
+		entries: map[string]metadataEntry{},
+		now:     time.Now,
+	}
+}
+
+// setTTL changes the time entries are kept for, a zero or negative ttl disables caching.
+// Entries already cached are dropped.
+func (c *metadataCache) setTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+	c.entries = map[string]metadataEntry{}
+}
+
+// clear drops every entry
+func (c *metadataCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]metadataEntry{}
+}
+
+// cachedMetadata returns the value cached under key, calling fetch when it is missing or expired
+func cachedMetadata[T any](ctx context.Context, c *metadataCache, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	ttl := c.ttl
+	c.mu.Unlock()
+
+	if ok && c.now().Before(entry.expires) {
+		return entry.value.(T), nil
+	}
+
+	value, err := fetch(ctx)
+	if err != nil || ttl <= 0 {
+		return value, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = metadataEntry{value: value, expires: c.now().Add(ttl)}
+	c.mu.Unlock()
+
+	return value, nil
+}
@@ -0,0 +1,190 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+// newMetadataServer serves canned metadata and counts the requests per path
+func newMetadataServer(t *testing.T, requests map[string]*atomic.Int32) *httptest.Server {
+	responses := map[string]string{
+		"/rest/api/3/issuetype":         `[{"id":"10001","name":"Bug"},{"id":"10002","name":"Story"}]`,
+		"/rest/api/3/issuetype/project": `[{"id":"10100","name":"Bug","scope":{"type":"PROJECT","project":{"id":"10000"}}}]`,
+		"/rest/api/3/issuetype/10001":   `{"id":"10001","name":"Bug"}`,
+		"/rest/api/3/priority":          `[{"id":"1","name":"Highest"},{"id":"2","name":"High"}]`,
+		"/rest/api/3/priority/2":        `{"id":"2","name":"High"}`,
+		"/rest/api/3/status":            `[{"id":"3","name":"In Progress"},{"id":"10005","name":"In Review"}]`,
+		"/rest/api/3/status/In Review":  `{"id":"10005","name":"In Review"}`,
+		"/rest/api/3/field":             `[{"id":"summary","name":"Summary"},{"id":"customfield_10016","name":"Story Points","custom":true,"schema":{"type":"number","customId":10016}},{"id":"customfield_10070","name":"Owner","custom":true},{"id":"customfield_10071","name":"owner","custom":true}]`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if counter, ok := requests[r.URL.Path]; ok {
+			counter.Add(1)
+		}
+		if r.URL.Path == "/rest/api/3/issuetype/project" && r.URL.Query().Get("projectId") != "10000" {
+			t.Errorf("projectId = %v, want 10000", r.URL.Query().Get("projectId"))
+		}
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+}
+
+func TestService_MetadataLookups(t *testing.T) {
+	requests := map[string]*atomic.Int32{
+		"/rest/api/3/issuetype":         new(atomic.Int32),
+		"/rest/api/3/issuetype/project": new(atomic.Int32),
+		"/rest/api/3/priority":          new(atomic.Int32),
+		"/rest/api/3/status":            new(atomic.Int32),
+		"/rest/api/3/field":             new(atomic.Int32),
+	}
+	server := newMetadataServer(t, requests)
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		lookup func() (string, error)
+		want   string
+	}{
+		{"issue type", func() (string, error) { return service.IssueTypeID(ctx, "", "bug") }, "10001"},
+		{"project issue type", func() (string, error) { return service.IssueTypeID(ctx, "10000", "Bug") }, "10100"},
+		{"priority", func() (string, error) { return service.PriorityID(ctx, "High") }, "2"},
+		{"status", func() (string, error) { return service.StatusID(ctx, "in review") }, "10005"},
+		{"status by ID", func() (string, error) { return service.StatusID(ctx, "3") }, "3"},
+		{"field", func() (string, error) { return service.FieldID(ctx, "Story Points") }, "customfield_10016"},
+	}
+
+	// Every lookup runs twice, the second time from the cache
+	for i := 0; i < 2; i++ {
+		for _, tt := range tests {
+			got, err := tt.lookup()
+			if err != nil {
+				t.Fatalf("%s lookup error = %v", tt.name, err)
+			}
+			if got != tt.want {
+				t.Errorf("%s lookup = %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	}
+
+	for path, counter := range requests {
+		if counter.Load() != 1 {
+			t.Errorf("requests to %s = %d, want 1", path, counter.Load())
+		}
+	}
+
+	if _, err := service.PriorityID(ctx, "Urgent"); !errors.Is(err, ErrMetadataNotFound) {
+		t.Errorf("PriorityID() error = %v, want ErrMetadataNotFound", err)
+	}
+}
+
+func TestService_MetadataCacheTTL(t *testing.T) {
+	requests := map[string]*atomic.Int32{"/rest/api/3/priority": new(atomic.Int32)}
+	server := newMetadataServer(t, requests)
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	now := time.Now()
+	service.metadata.now = func() time.Time { return now }
+
+	service.ListPriorities(ctx)
+	service.ListPriorities(ctx)
+	if got := requests["/rest/api/3/priority"].Load(); got != 1 {
+		t.Errorf("requests = %d, want 1 within the TTL", got)
+	}
+
+	now = now.Add(METADATA_CACHE_TTL + time.Second)
+	service.ListPriorities(ctx)
+	if got := requests["/rest/api/3/priority"].Load(); got != 2 {
+		t.Errorf("requests = %d, want 2 after the TTL", got)
+	}
+
+	service.ClearMetadataCache()
+	service.ListPriorities(ctx)
+	if got := requests["/rest/api/3/priority"].Load(); got != 3 {
+		t.Errorf("requests = %d, want 3 after clearing the cache", got)
+	}
+
+	service.SetMetadataCacheTTL(0)
+	service.ListPriorities(ctx)
+	service.ListPriorities(ctx)
+	if got := requests["/rest/api/3/priority"].Load(); got != 5 {
+		t.Errorf("requests = %d, want 5 with caching disabled", got)
+	}
+}
+
+func TestService_MetadataCacheIsolation(t *testing.T) {
+	server := newMetadataServer(t, map[string]*atomic.Int32{})
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	statuses, _ := service.ListStatuses(ctx)
+	statuses[0].Name = "Modified"
+
+	again, err := service.ListStatuses(ctx)
+	if err != nil {
+		t.Fatalf("ListStatuses() error = %v", err)
+	}
+	if again[0].Name != "In Progress" {
+		t.Errorf("cached status name = %v, want In Progress", again[0].Name)
+	}
+}
+
+func TestService_MetadataGet(t *testing.T) {
+	server := newMetadataServer(t, map[string]*atomic.Int32{})
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	issueType, err := service.GetIssueType(ctx, "10001")
+	if err != nil || issueType.Name != "Bug" {
+		t.Errorf("GetIssueType() = %+v, %v", issueType, err)
+	}
+	priority, err := service.GetPriority(ctx, "2")
+	if err != nil || priority.Name != "High" {
+		t.Errorf("GetPriority() = %+v, %v", priority, err)
+	}
+	status, err := service.GetStatus(ctx, "In Review")
+	if err != nil || status.ID != "10005" {
+		t.Errorf("GetStatus() = %+v, %v", status, err)
+	}
+	field, err := service.GetField(ctx, "customfield_10016")
+	if err != nil || field.Name != "Story Points" || field.Schema.Type != "number" {
+		t.Errorf("GetField() = %+v, %v", field, err)
+	}
+
+	if _, err := service.GetIssueType(ctx, "404"); err == nil {
+		t.Error("GetIssueType() expected error for missing issue type, got nil")
+	}
+	if _, err := service.GetField(ctx, "Team"); !errors.Is(err, ErrMetadataNotFound) {
+		t.Errorf("GetField() error = %v, want ErrMetadataNotFound", err)
+	}
+	if _, err := service.FieldID(ctx, "Owner"); !errors.Is(err, ErrAmbiguousField) ||
+		!strings.Contains(err.Error(), "customfield_10070, customfield_10071") {
+		t.Errorf("FieldID() error = %v, want ErrAmbiguousField listing both IDs", err)
+	}
+	if field, err := service.GetField(ctx, "customfield_10071"); err != nil || field.Name != "owner" {
+		t.Errorf("GetField() by ID = %+v, %v, want owner", field, err)
+	}
+}
@@ -1,5 +1,7 @@
 package issue
 
+import "time"
+
 // IssueGetOpts represents options for getting an issue
 type IssueGetOpts struct {
 	// Expand options that include additional issue details in the response
@@ -38,18 +40,19 @@ type IssueSearchOpts struct {
 
 // IssueCreateOpts represents options for creating an issue
 type IssueCreateOpts struct {
-	// Whether to update the issue history
+	// Whether the project in which the issue is created is added to the user's Recently viewed project list
 	UpdateHistory bool
 }
 
 // IssueUpdateOpts represents options for updating an issue
 type IssueUpdateOpts struct {
-	// Whether to notify users about the update
-	NotifyUsers bool
-	
+	// Whether to notify users about the update. Nil keeps Jira's default, which is to notify.
+	// Disabling notifications requires the Administer Jira or Administer project permission.
+	NotifyUsers *bool
+
 	// Whether to override screen security
 	OverrideScreenSecurity bool
-	
+
 	// Whether to override editable fields
 	OverrideEditableFields bool
 }
@@ -60,15 +63,24 @@ type IssueDeleteOpts struct {
 	DeleteSubtasks bool
 }
 
-// IssueTransitionOpts represents options for transitioning an issue
+// IssueTransitionOpts represents options for listing the transitions of an issue
 type IssueTransitionOpts struct {
-	// Expand options for the transition response
+	// Expand options for the transition response, e.g. "transitions.fields" for the fields on the transition screen
 	Expand []string
+
+	// Only return the transition with this ID
+	TransitionID string
+
+	// Whether to skip conditions that are only evaluated remotely
+	SkipRemoteOnlyCondition bool
+
+	// Whether to include transitions that are unavailable because their conditions are not met
+	IncludeUnavailableTransitions bool
 }
 
 // IssueCommentOpts represents options for issue comments
 type IssueCommentOpts struct {
-	// Expand options for comments
+	// Expand options for comments, e.g. COMMENT_EXPAND_RENDERED_BODY
 	Expand []string
 	
 	// Maximum number of results to return
@@ -77,10 +89,32 @@ type IssueCommentOpts struct {
 	// Starting index for pagination
 	StartAt int
 	
-	// Order by field (created, updated)
+	// Order by created date, "created" or "-created" for newest first
 	OrderBy string
 }
 
+// IssueCommentUpdateOpts represents options for updating an issue comment
+type IssueCommentUpdateOpts struct {
+	// Expand options for the returned comment, e.g. COMMENT_EXPAND_RENDERED_BODY
+	Expand []string
+
+	// Whether to notify users about the update. Nil keeps Jira's default, which is to notify.
+	NotifyUsers *bool
+
+	// Whether to update the comment even when the issue is not editable, e.g. when it is closed.
+	// Only available to Connect and Forge app users with the Administer Jira permission.
+	OverrideEditableFlag bool
+}
+
+// IssueChangelogOpts represents options for listing the changelog of an issue
+type IssueChangelogOpts struct {
+	// Maximum number of results to return (default: 100)
+	MaxResults int
+
+	// Starting index for pagination
+	StartAt int
+}
+
 // IssueWorklogOpts represents options for issue worklogs
 type IssueWorklogOpts struct {
 	// Expand options for worklogs
@@ -91,4 +125,60 @@ type IssueWorklogOpts struct {
 	
 	// Starting index for pagination
 	StartAt int
+
+	// Only return worklogs started on or after this time
+	StartedAfter time.Time
+
+	// Only return worklogs started on or before this time
+	StartedBefore time.Time
+}
+
+// IssueWorklogWriteOpts represents options for adding, updating and deleting issue worklogs
+type IssueWorklogWriteOpts struct {
+	// Expand options for the returned worklog, e.g. "properties". Not sent by DeleteWorklog
+	Expand []string
+
+	// Whether to notify users watching the issue. Nil keeps Jira's default, which is to notify.
+	NotifyUsers *bool
+
+	// How the remaining estimate is adjusted, one of the ADJUST_ESTIMATE_* modes. Empty means auto
+	AdjustEstimate string
+
+	// The new remaining estimate, e.g. "2d", required with ADJUST_ESTIMATE_NEW
+	NewEstimate string
+
+	// The amount to reduce the remaining estimate by when adding, required with ADJUST_ESTIMATE_MANUAL
+	ReduceBy string
+
+	// The amount to increase the remaining estimate by when deleting, required with ADJUST_ESTIMATE_MANUAL
+	IncreaseBy string
+
+	// Whether to write the worklog even when the issue is not editable, e.g. when it is closed.
+	// Only available to Connect and Forge app users with the Administer Jira permission.
+	OverrideEditableFlag bool
+}
+
+// AttachmentThumbnailOpts represents options for downloading an attachment thumbnail
+type AttachmentThumbnailOpts struct {
+	// Maximum width to scale the thumbnail to
+	Width int
+
+	// Maximum height to scale the thumbnail to
+	Height int
+
+	// Whether to return a default thumbnail when the requested one can't be generated
+	FallbackToDefault bool
+}
+
+// IssueLinkWalkOpts represents options for walking the links between issues
+type IssueLinkWalkOpts struct {
+	// Names of the link types to follow. Defaults to ISSUE_LINK_TYPE_BLOCKS
+	LinkTypes []string
+
+	// Which side of the links to follow, one of the LINK_DIRECTION_* values.
+	// Defaults to LINK_DIRECTION_INWARD, which for "Blocks" links collects every issue blocking the root
+	Direction string
+
+	// Maximum number of links between the root and any issue in the graph. Zero or negative means no limit
+	MaxDepth int
 }
@@ -0,0 +1,155 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// TransitionNotFoundError is returned by TransitionTo when no available transition leads to the target status
+type TransitionNotFoundError struct {
+	// The issue that could not be transitioned
+	IssueIDOrKey string
+
+	// The requested target status name or ID
+	Target string
+
+	// The transitions that were available for the issue
+	Available []responsetypes.Transition
+}
+
+// Error implements the error interface
+func (e *TransitionNotFoundError) Error() string {
+	available := make([]string, 0, len(e.Available))
+	for _, t := range e.Available {
+		available = append(available, fmt.Sprintf("%q (%s) -> %q", t.Name, t.ID, t.To.Name))
+	}
+	if len(available) == 0 {
+		return fmt.Sprintf("issue %s cannot transition to %q: no transitions available", e.IssueIDOrKey, e.Target)
+	}
+	return fmt.Sprintf("issue %s cannot transition to %q, available transitions: %s", e.IssueIDOrKey, e.Target, strings.Join(available, ", "))
+}
+
+// GetTransitions retrieves the transitions the current user can perform on an issue in its current status
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-transitions-get
+func (s *Service) GetTransitions(ctx context.Context, issueIDOrKey string, opts IssueTransitionOpts) (*TransitionsResponse, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_TRANSITIONS_ENDPOINT, issueIDOrKey)
+	params := url.Values{}
+
+	if len(opts.Expand) > 0 {
+		params.Add("expand", strings.Join(opts.Expand, ","))
+	}
+	if opts.TransitionID != "" {
+		params.Add("transitionId", opts.TransitionID)
+	}
+	if opts.SkipRemoteOnlyCondition {
+		params.Add("skipRemoteOnlyCondition", "true")
+	}
+	if opts.IncludeUnavailableTransitions {
+		params.Add("includeUnavailableTransitions", "true")
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	transitions := new(TransitionsResponse)
+	if err := s.client.Do(req, transitions); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return transitions, nil
+}
+
+// DoTransition performs a transition on an issue, optionally setting fields and adding a comment
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-transitions-post
+func (s *Service) DoTransition(ctx context.Context, issueIDOrKey string, request TransitionRequest) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+	if request.Transition.ID == "" {
+		return fmt.Errorf("transition ID is required")
+	}
+
+	if request.Comment != nil {
+		update := make(map[string][]FieldOperation, len(request.Update)+1)
+		for field, operations := range request.Update {
+			update[field] = operations
+		}
+		update["comment"] = append(update["comment"], FieldOperation{
+			Add: map[string]interface{}{"body": request.Comment},
+		})
+		request.Update = update
+	}
+
+	if err := s.checkADF(request); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf(ISSUE_TRANSITIONS_ENDPOINT, issueIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// TransitionTo moves an issue to the target status, given as a status name (case-insensitive) or status ID.
+// When no status matches, a transition with that name is used instead.
+// A *TransitionNotFoundError listing the available transitions is returned when the target can't be reached.
+func (s *Service) TransitionTo(ctx context.Context, issueIDOrKey string, target string) error {
+	if target == "" {
+		return fmt.Errorf("target status is required")
+	}
+
+	transitions, err := s.GetTransitions(ctx, issueIDOrKey, IssueTransitionOpts{})
+	if err != nil {
+		return err
+	}
+
+	transition, ok := findTransition(transitions.Transitions, target)
+	if !ok {
+		return &TransitionNotFoundError{
+			IssueIDOrKey: issueIDOrKey,
+			Target:       target,
+			Available:    transitions.Transitions,
+		}
+	}
+
+	return s.DoTransition(ctx, issueIDOrKey, TransitionRequest{
+		Transition: TransitionReference{ID: transition.ID},
+	})
+}
+
+// findTransition returns the transition leading to the target status, falling back to the transition name
+func findTransition(transitions []responsetypes.Transition, target string) (responsetypes.Transition, bool) {
+	for _, t := range transitions {
+		if t.To.ID == target || strings.EqualFold(t.To.Name, target) {
+			return t, true
+		}
+	}
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, target) {
+			return t, true
+		}
+	}
+	return responsetypes.Transition{}, false
+}
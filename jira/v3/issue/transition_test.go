@@ -0,0 +1,230 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+var testTransitions = TransitionsResponse{
+	Transitions: []responsetypes.Transition{
+		{ID: "11", Name: "Start Progress", To: responsetypes.StatusDetails{ID: "3", Name: "In Progress"}},
+		{ID: "31", Name: "Resolve", To: responsetypes.StatusDetails{ID: "10001", Name: "Done"}},
+		{ID: "41", Name: "Reopen", To: responsetypes.StatusDetails{ID: "1", Name: "Open"}},
+	},
+}
+
+func TestService_GetTransitions(t *testing.T) {
+	tests := []struct {
+		name       string
+		issueKey   string
+		opts       IssueTransitionOpts
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "success",
+			issueKey:   "TEST-1",
+			wantURL:    "/rest/api/3/issue/TEST-1/transitions",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:     "success - with options",
+			issueKey: "TEST-1",
+			opts: IssueTransitionOpts{
+				Expand:                        []string{"transitions.fields"},
+				TransitionID:                  "31",
+				IncludeUnavailableTransitions: true,
+			},
+			wantURL:    "/rest/api/3/issue/TEST-1/transitions?expand=transitions.fields&includeUnavailableTransitions=true&transitionId=31",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:    "error - missing key",
+			wantErr: true,
+		},
+		{
+			name:       "error - not found",
+			issueKey:   "TEST-404",
+			wantURL:    "/rest/api/3/issue/TEST-404/transitions",
+			wantErr:    true,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode == http.StatusOK {
+					json.NewEncoder(w).Encode(testTransitions)
+				}
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			got, err := service.GetTransitions(context.Background(), tt.issueKey, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetTransitions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(got.Transitions) != len(testTransitions.Transitions) {
+				t.Errorf("GetTransitions() returned %d transitions, want %d", len(got.Transitions), len(testTransitions.Transitions))
+			}
+		})
+	}
+}
+
+func TestService_DoTransition(t *testing.T) {
+	comment := map[string]interface{}{"type": "doc", "version": 1, "content": []interface{}{}}
+
+	tests := []struct {
+		name     string
+		issueKey string
+		request  TransitionRequest
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name:     "success",
+			issueKey: "TEST-1",
+			request:  TransitionRequest{Transition: TransitionReference{ID: "31"}},
+			wantBody: `{"transition":{"id":"31"}}`,
+		},
+		{
+			name:     "success - fields and comment",
+			issueKey: "TEST-1",
+			request: TransitionRequest{
+				Transition: TransitionReference{ID: "31"},
+				Fields:     map[string]interface{}{"resolution": map[string]string{"name": "Fixed"}},
+				Update:     map[string][]FieldOperation{"labels": {{Add: "released"}}},
+				Comment:    comment,
+			},
+			wantBody: `{"transition":{"id":"31"},"fields":{"resolution":{"name":"Fixed"}},"update":{"comment":[{"add":{"body":{"content":[],"type":"doc","version":1}}}],"labels":[{"add":"released"}]}}`,
+		},
+		{
+			name:     "error - missing transition",
+			issueKey: "TEST-1",
+			wantErr:  true,
+		},
+		{
+			name:    "error - missing key",
+			request: TransitionRequest{Transition: TransitionReference{ID: "31"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want POST", r.Method)
+				}
+				if r.URL.Path != "/rest/api/3/issue/TEST-1/transitions" {
+					t.Errorf("Path = %v", r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if strings.TrimSpace(string(body)) != tt.wantBody {
+					t.Errorf("Body = %s, want %s", body, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			err := service.DoTransition(context.Background(), tt.issueKey, tt.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DoTransition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_TransitionTo(t *testing.T) {
+	tests := []struct {
+		name             string
+		target           string
+		wantTransitionID string
+		wantErr          bool
+	}{
+		{
+			name:             "by status name",
+			target:           "done",
+			wantTransitionID: "31",
+		},
+		{
+			name:             "by status ID",
+			target:           "3",
+			wantTransitionID: "11",
+		},
+		{
+			name:             "by transition name",
+			target:           "Reopen",
+			wantTransitionID: "41",
+		},
+		{
+			name:    "unreachable status",
+			target:  "Closed",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotTransitionID string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(testTransitions)
+				case http.MethodPost:
+					var request TransitionRequest
+					if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+						t.Errorf("Failed to decode request body: %v", err)
+					}
+					gotTransitionID = request.Transition.ID
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			err := service.TransitionTo(context.Background(), "TEST-1", tt.target)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TransitionTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotTransitionID != tt.wantTransitionID {
+				t.Errorf("transition ID = %q, want %q", gotTransitionID, tt.wantTransitionID)
+			}
+
+			if tt.wantErr {
+				var notFound *TransitionNotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("TransitionTo() error = %T, want *TransitionNotFoundError", err)
+				}
+				if len(notFound.Available) != len(testTransitions.Transitions) {
+					t.Errorf("Available = %d transitions, want %d", len(notFound.Available), len(testTransitions.Transitions))
+				}
+				if !strings.Contains(err.Error(), `"Resolve" (31) -> "Done"`) {
+					t.Errorf("Error() = %v, want available transitions listed", err)
+				}
+			}
+		})
+	}
+}
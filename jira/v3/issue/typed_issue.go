@@ -0,0 +1,218 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// JIRA_TAG is the struct tag read by GetInto and SearchJQLInto.
+// The tag holds a field ID, e.g. `jira:"summary"` or `jira:"customfield_10016"`,
+// or a display name resolved with the field registry, e.g. `jira:"name:Story Points"`.
+// The tags `jira:"id"`, `jira:"key"` and `jira:"self"` receive the attributes of the issue itself.
+const JIRA_TAG = "jira"
+
+// jiraTagNamePrefix marks a tag holding a field display name instead of a field ID
+const jiraTagNamePrefix = "name:"
+
+// issueAttributes are the tags decoded from the issue itself rather than from its fields
+var issueAttributes = []string{"id", "key", "self"}
+
+// rawIssue is an issue with its fields kept as raw JSON
+type rawIssue struct {
+	ID     json.RawMessage            `json:"id"`
+	Key    json.RawMessage            `json:"key"`
+	Self   json.RawMessage            `json:"self"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+// value returns the raw JSON of an issue attribute or field
+func (r rawIssue) value(ref string) json.RawMessage {
+	switch ref {
+	case "id":
+		return r.ID
+	case "key":
+		return r.Key
+	case "self":
+		return r.Self
+	}
+	return r.Fields[ref]
+}
+
+// name returns the key of the issue, or its ID when it has no key, or fallback when it has neither
+func (r rawIssue) name(fallback string) string {
+	for _, raw := range []json.RawMessage{r.Key, r.ID} {
+		var name string
+		if json.Unmarshal(raw, &name) == nil && name != "" {
+			return name
+		}
+	}
+	return fallback
+}
+
+// issueDecoder decodes raw issues into struct values following their jira tags
+type issueDecoder struct {
+	fields []taggedField
+}
+
+// taggedField is a struct field with a jira tag
+type taggedField struct {
+	index int
+
+	// The issue attribute or field ID the struct field is decoded from
+	ref string
+}
+
+// newIssueDecoder reads the jira tags of t, resolving display names with the field registry when needed
+func (s *Service) newIssueDecoder(ctx context.Context, t reflect.Type) (*issueDecoder, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("type %s is not a struct", t)
+	}
+
+	var registry *FieldRegistry
+	decoder := new(issueDecoder)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get(JIRA_TAG)
+		if tag == "" || tag == "-" {
+			continue
+		}
+		if !field.IsExported() {
+			return nil, fmt.Errorf("field %s of type %s has a jira tag but is not exported", field.Name, t)
+		}
+
+		ref := tag
+		if name, ok := strings.CutPrefix(tag, jiraTagNamePrefix); ok {
+			if registry == nil {
+				var err error
+				if registry, err = s.FieldRegistry(ctx); err != nil {
+					return nil, fmt.Errorf("error loading field registry: %w", err)
+				}
+			}
+			id, err := registry.ID(name)
+			if err != nil {
+				return nil, err
+			}
+			ref = id
+		}
+		decoder.fields = append(decoder.fields, taggedField{index: i, ref: ref})
+	}
+
+	if len(decoder.fields) == 0 {
+		return nil, fmt.Errorf("type %s has no jira tags", t)
+	}
+	return decoder, nil
+}
+
+// fieldIDs returns the IDs of the fields to request, without duplicates and issue attributes.
+// When only issue attributes are tagged, "id" is returned so that no fields are sent back.
+func (d *issueDecoder) fieldIDs(extra []string) []string {
+	ids := slices.Clone(extra)
+	for _, field := range d.fields {
+		if !slices.Contains(issueAttributes, field.ref) && !slices.Contains(ids, field.ref) {
+			ids = append(ids, field.ref)
+		}
+	}
+	if len(ids) == 0 {
+		return []string{"id"}
+	}
+	return ids
+}
+
+// decode sets the tagged fields of target, a pointer to a struct, leaving fields missing from the issue or null untouched.
+// Errors name the issue by key or ID, or by fallback when the issue has neither.
+func (d *issueDecoder) decode(issue rawIssue, fallback string, target reflect.Value) error {
+	for _, field := range d.fields {
+		raw := issue.value(field.ref)
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(raw, target.Elem().Field(field.index).Addr().Interface()); err != nil {
+			return fmt.Errorf("error decoding field %s of issue %s: %w", field.ref, issue.name(fallback), err)
+		}
+	}
+	return nil
+}
+
+// GetInto retrieves an issue by its ID or key and decodes it into a T, a struct with jira tags.
+// Only the fields T needs are requested.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-get
+func GetInto[T any](ctx context.Context, s *Service, issueIDOrKey string, expand []string) (*T, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	decoder, err := s.newIssueDecoder(ctx, reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+
+	var issue rawIssue
+	if err := s.getIssue(ctx, issueIDOrKey, expand, decoder.fieldIDs(nil), nil, &issue); err != nil {
+		return nil, err
+	}
+
+	target := new(T)
+	if err := decoder.decode(issue, issueIDOrKey, reflect.ValueOf(target)); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// SearchJQLInto searches for issues using JQL and decodes every issue into a T, a struct with jira tags.
+// The fields T needs are added to request.Fields. Pages are followed as in SearchJQLAll,
+// and when maxIssues is greater than zero, at most maxIssues issues are returned.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-search/#api-rest-api-3-search-jql-post
+func SearchJQLInto[T any](ctx context.Context, s *Service, request JQLSearchRequest, maxIssues int) ([]T, error) {
+	var issues []T
+	for issue, err := range SearchJQLIterInto[T](ctx, s, request, maxIssues) {
+		if err != nil {
+			return issues, err
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// SearchJQLIterInto returns an iterator over every issue matching the JQL query, decoded into a T.
+// It behaves as SearchJQLIter: nothing is requested, not even the field registry, until the iteration starts.
+func SearchJQLIterInto[T any](ctx context.Context, s *Service, request JQLSearchRequest, maxIssues int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		decoder, err := s.newIssueDecoder(ctx, reflect.TypeFor[T]())
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		search := request
+		search.Fields = decoder.fieldIDs(request.Fields)
+
+		searchJQLInto[T](ctx, s, decoder, search, maxIssues)(yield)
+	}
+}
+
+// searchJQLInto returns an iterator over the issues matching the JQL query, decoded into a T by decoder
+func searchJQLInto[T any](ctx context.Context, s *Service, decoder *issueDecoder, request JQLSearchRequest, maxIssues int) iter.Seq2[T, error] {
+	return searchJQLPages(ctx, request, maxIssues, func(ctx context.Context, request JQLSearchRequest) (jqlSearchPage[T], error) {
+		var raw jqlSearchPage[rawIssue]
+		if err := s.searchJQL(ctx, request, &raw); err != nil {
+			return jqlSearchPage[T]{}, err
+		}
+
+		page := jqlSearchPage[T]{
+			IsLast:        raw.IsLast,
+			NextPageToken: raw.NextPageToken,
+			Issues:        make([]T, len(raw.Issues)),
+		}
+		for i, issue := range raw.Issues {
+			if err := decoder.decode(issue, fmt.Sprintf("at index %d of the page", i), reflect.ValueOf(&page.Issues[i])); err != nil {
+				return jqlSearchPage[T]{}, err
+			}
+		}
+		return page, nil
+	})
+}
@@ -0,0 +1,259 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+type storyIssue struct {
+	Key         string        `jira:"key"`
+	Summary     string        `jira:"summary"`
+	Status      StatusDetails `jira:"status"`
+	Assignee    *SimpleUser   `jira:"assignee"`
+	StoryPoints float64       `jira:"name:Story Points"`
+	Sprint      []int         `jira:"customfield_10020"`
+	Ignored     string        `jira:"-"`
+	Untagged    string
+}
+
+func TestGetInto(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			w.Write([]byte(customFieldsJSON))
+		case "/rest/api/3/issue/TEST-1":
+			wantFields := []string{"summary", "status", "assignee", "customfield_10016", "customfield_10020"}
+			if got := r.URL.Query()["fields"]; !reflect.DeepEqual(got, wantFields) {
+				t.Errorf("fields = %v, want %v", got, wantFields)
+			}
+			w.Write([]byte(`{"id":"10001","key":"TEST-1","fields":{
+				"summary":"Build failed",
+				"status":{"id":"3","name":"In Progress"},
+				"assignee":null,
+				"customfield_10016":5.5,
+				"customfield_10020":[7,8]
+			}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+
+	got, err := GetInto[storyIssue](context.Background(), service, "TEST-1", nil)
+	if err != nil {
+		t.Fatalf("GetInto() error = %v", err)
+	}
+	want := &storyIssue{
+		Key:         "TEST-1",
+		Summary:     "Build failed",
+		Status:      StatusDetails{ID: "3", Name: "In Progress"},
+		StoryPoints: 5.5,
+		Sprint:      []int{7, 8},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetInto() = %+v, want %+v", got, want)
+	}
+
+	if _, err := GetInto[storyIssue](context.Background(), service, "", nil); err == nil {
+		t.Error("GetInto() expected error for empty issue key, got nil")
+	}
+}
+
+func TestGetInto_InvalidType(t *testing.T) {
+	service := NewService(nil, "https://example.atlassian.net", auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	type unexported struct {
+		summary string `jira:"summary"`
+	}
+	type untagged struct {
+		Summary string
+	}
+
+	if _, err := GetInto[string](ctx, service, "TEST-1", nil); err == nil {
+		t.Error("GetInto[string]() expected error, got nil")
+	}
+	if _, err := GetInto[unexported](ctx, service, "TEST-1", nil); err == nil {
+		t.Error("GetInto() expected error for an unexported tagged field, got nil")
+	}
+	if _, err := GetInto[untagged](ctx, service, "TEST-1", nil); err == nil {
+		t.Error("GetInto() expected error for a type without tags, got nil")
+	}
+}
+
+func TestSearchJQLInto(t *testing.T) {
+	pages := []string{
+		`{"isLast":false,"nextPageToken":"page2","issues":[
+			{"key":"TEST-1","fields":{"summary":"First","customfield_10016":3}},
+			{"key":"TEST-2","fields":{"summary":"Second","assignee":{"accountId":"abc","displayName":"Mia Krystof"}}}
+		]}`,
+		`{"isLast":true,"issues":[{"key":"TEST-3","fields":{"summary":"Third"}}]}`,
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/rest/api/3/field" {
+			w.Write([]byte(customFieldsJSON))
+			return
+		}
+
+		var body JQLSearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		wantFields := []string{"labels", "summary", "status", "assignee", "customfield_10016", "customfield_10020"}
+		if !reflect.DeepEqual(body.Fields, wantFields) {
+			t.Errorf("fields = %v, want %v", body.Fields, wantFields)
+		}
+		w.Write([]byte(pages[requests]))
+		requests++
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+
+	got, err := SearchJQLInto[storyIssue](context.Background(), service, JQLSearchRequest{
+		JQL:    "project = TEST",
+		Fields: []string{"labels", "summary"},
+	}, 0)
+	if err != nil {
+		t.Fatalf("SearchJQLInto() error = %v", err)
+	}
+	if len(got) != 3 || requests != 2 {
+		t.Fatalf("SearchJQLInto() returned %d issues in %d requests, want 3 in 2", len(got), requests)
+	}
+	if got[0].Key != "TEST-1" || got[0].StoryPoints != 3 {
+		t.Errorf("issues[0] = %+v", got[0])
+	}
+	if got[1].Assignee == nil || got[1].Assignee.DisplayName != "Mia Krystof" {
+		t.Errorf("issues[1].Assignee = %+v", got[1].Assignee)
+	}
+	if got[2].Summary != "Third" {
+		t.Errorf("issues[2].Summary = %v, want Third", got[2].Summary)
+	}
+}
+
+func TestSearchJQLInto_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			w.Write([]byte(customFieldsJSON))
+		default:
+			w.Write([]byte(`{"isLast":true,"issues":[{"key":"TEST-1","fields":{"summary":42}}]}`))
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	_, err := SearchJQLInto[storyIssue](ctx, service, JQLSearchRequest{JQL: "project = TEST"}, 0)
+	if err == nil || !strings.HasPrefix(err.Error(), "error decoding field summary of issue TEST-1:") {
+		t.Errorf("SearchJQLInto() error = %v, want a decoding error naming TEST-1", err)
+	}
+
+	type teamIssue struct {
+		Team string `jira:"name:Team"`
+	}
+	if _, err := SearchJQLInto[teamIssue](ctx, service, JQLSearchRequest{JQL: "project = TEST"}, 0); !errors.Is(err, ErrAmbiguousField) {
+		t.Errorf("SearchJQLInto() error = %v, want ErrAmbiguousField", err)
+	}
+	if _, err := SearchJQLInto[storyIssue](ctx, service, JQLSearchRequest{}, 0); err == nil {
+		t.Error("SearchJQLInto() expected error for empty JQL, got nil")
+	}
+}
+
+func TestSearchJQLIterInto_Lazy(t *testing.T) {
+	var paths []string
+	var gotFields [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			w.Write([]byte(customFieldsJSON))
+		case "/rest/api/3/issue/TEST-1":
+			gotFields = append(gotFields, r.URL.Query()["fields"])
+			w.Write([]byte(`{"id":"10001","key":"TEST-1"}`))
+		default:
+			var body JQLSearchRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			gotFields = append(gotFields, body.Fields)
+			w.Write([]byte(`{"isLast":true,"issues":[{"id":"10001","key":"TEST-1"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	ctx := context.Background()
+
+	// Nothing is requested until the iteration starts
+	issues := SearchJQLIterInto[storyIssue](ctx, service, JQLSearchRequest{JQL: "project = TEST"}, 0)
+	if len(paths) != 0 {
+		t.Fatalf("requests before ranging = %v, want none", paths)
+	}
+	for _, err := range issues {
+		if err != nil {
+			t.Fatalf("SearchJQLIterInto() error = %v", err)
+		}
+	}
+	if want := []string{"/rest/api/3/field", "/rest/api/3/search/jql"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("requests = %v, want %v", paths, want)
+	}
+
+	// A type tagged with issue attributes only asks for no fields
+	type keyIssue struct {
+		ID  string `jira:"id"`
+		Key string `jira:"key"`
+	}
+	gotFields = nil
+	for issue, err := range SearchJQLIterInto[keyIssue](ctx, service, JQLSearchRequest{JQL: "project = TEST"}, 0) {
+		if err != nil || issue.Key != "TEST-1" {
+			t.Errorf("SearchJQLIterInto() = %+v, %v, want TEST-1", issue, err)
+		}
+	}
+	if got, err := GetInto[keyIssue](ctx, service, "TEST-1", nil); err != nil || got.ID != "10001" {
+		t.Errorf("GetInto() = %+v, %v, want ID 10001", got, err)
+	}
+	if want := [][]string{{"id"}, {"id"}}; !reflect.DeepEqual(gotFields, want) {
+		t.Errorf("fields = %v, want %v", gotFields, want)
+	}
+}
+
+func TestIssueDecoder_ErrorNamesIssue(t *testing.T) {
+	decoder := &issueDecoder{fields: []taggedField{{index: 1, ref: "summary"}}}
+	fields := map[string]json.RawMessage{"summary": json.RawMessage(`42`)}
+
+	tests := []struct {
+		name  string
+		issue rawIssue
+		want  string
+	}{
+		{"key", rawIssue{ID: json.RawMessage(`"10001"`), Key: json.RawMessage(`"TEST-1"`), Fields: fields}, "of issue TEST-1:"},
+		{"ID without key", rawIssue{ID: json.RawMessage(`"10001"`), Fields: fields}, "of issue 10001:"},
+		{"neither", rawIssue{Fields: fields}, "of issue at index 2 of the page:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := decoder.decode(tt.issue, "at index 2 of the page", reflect.ValueOf(new(storyIssue)))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("decode() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,13 @@
 package issue
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+	"github.com/ducminhgd/go-atlassian/jira/v3/utils"
+)
+
 // JQLSearchRequest represents the request body for JQL search
 type JQLSearchRequest struct {
 	// Expand options that include additional issue details in the response
@@ -48,6 +56,93 @@ type JQLSearchResponse struct {
 	Total int `json:"total,omitempty"`
 }
 
+// IssueCreateRequest represents the request body for creating an issue
+type IssueCreateRequest struct {
+	// Field values keyed by field ID, e.g. "project", "issuetype", "summary" or "customfield_10016"
+	Fields map[string]interface{} `json:"fields,omitempty"`
+
+	// Operations to apply to fields, keyed by field ID
+	Update map[string][]FieldOperation `json:"update,omitempty"`
+
+	// Field values keyed by field name, e.g. "Story Points". Names are resolved to
+	// field IDs through the field registry and merged into Fields.
+	CustomFields map[string]interface{} `json:"-"`
+}
+
+// IssueUpdateRequest represents the request body for editing an issue
+type IssueUpdateRequest struct {
+	// Field values to set, keyed by field ID. A nil value clears the field
+	Fields map[string]interface{} `json:"fields,omitempty"`
+
+	// Operations to apply to fields, keyed by field ID
+	Update map[string][]FieldOperation `json:"update,omitempty"`
+
+	// Field values keyed by field name, e.g. "Story Points". Names are resolved to
+	// field IDs through the field registry and merged into Fields.
+	CustomFields map[string]interface{} `json:"-"`
+}
+
+// FieldOperation represents an operation applied to a field through the "update" payload.
+// Only one of the operations is expected to be set.
+type FieldOperation struct {
+	// Adds a value to a multi-value field
+	Add interface{} `json:"add,omitempty"`
+
+	// Sets the value of the field
+	Set interface{} `json:"set,omitempty"`
+
+	// Removes a value from a multi-value field
+	Remove interface{} `json:"remove,omitempty"`
+
+	// Edits a value, e.g. the time tracking estimates
+	Edit interface{} `json:"edit,omitempty"`
+
+	// Copies the value from another issue, e.g. attachments
+	Copy interface{} `json:"copy,omitempty"`
+}
+
+// CreatedIssue represents the response of creating an issue
+type CreatedIssue struct {
+	// The ID of the created issue
+	ID string `json:"id"`
+
+	// The key of the created issue
+	Key string `json:"key"`
+
+	// The self URL of the created issue
+	Self string `json:"self"`
+}
+
+// TransitionsResponse represents the transitions available for an issue
+type TransitionsResponse struct {
+	// Expand options that include additional transition details in the response
+	Expand string `json:"expand,omitempty"`
+
+	// The transitions the current user can perform on the issue
+	Transitions []responsetypes.Transition `json:"transitions"`
+}
+
+// TransitionRequest represents the request body for transitioning an issue
+type TransitionRequest struct {
+	// The transition to perform, only the ID is required
+	Transition TransitionReference `json:"transition"`
+
+	// Field values to set on the transition screen, keyed by field ID
+	Fields map[string]interface{} `json:"fields,omitempty"`
+
+	// Operations to apply to fields, keyed by field ID
+	Update map[string][]FieldOperation `json:"update,omitempty"`
+
+	// Comment added with the transition, as an ADF document.
+	// It is sent as an "add" operation on the comment field.
+	Comment interface{} `json:"-"`
+}
+
+// TransitionReference identifies a transition in a TransitionRequest
+type TransitionReference struct {
+	ID string `json:"id"`
+}
+
 // Issue represents a Jira issue (re-exported from responsetypes for convenience)
 type Issue struct {
 	// Expand options that include additional issue details in the response
@@ -67,6 +162,9 @@ type Issue struct {
 
 	// Changelog information (when expanded)
 	Changelog PageOfChangelogs `json:"changelog,omitempty"`
+
+	// Resolves field names in CustomField, set by the service once a field registry is loaded
+	registry *FieldRegistry
 }
 
 // IssueFields represents the fields of an issue
@@ -122,11 +220,20 @@ type IssueFields struct {
 	// Comment information
 	Comment PagedComment `json:"comment,omitempty"`
 
+	// Attachments of the issue
+	Attachment []Attachment `json:"attachment,omitempty"`
+
+	// Links to other issues
+	IssueLinks []IssueLink `json:"issuelinks,omitempty"`
+
 	// Created timestamp
 	Created string `json:"created,omitempty"`
 
 	// Updated timestamp
 	Updated string `json:"updated,omitempty"`
+
+	// Fields without a dedicated struct field, such as custom fields, keyed by field ID
+	Unknowns map[string]json.RawMessage `json:"-"`
 }
 
 // SimpleUser represents a basic user structure
@@ -253,6 +360,36 @@ type WatchInfo struct {
 	Self string `json:"self,omitempty"`
 }
 
+// Watchers represents the users watching an issue
+type Watchers struct {
+	// URL of the watchers resource
+	Self string `json:"self,omitempty"`
+
+	// Whether the current user is watching the issue
+	IsWatching bool `json:"isWatching"`
+
+	// Number of watchers
+	WatchCount int `json:"watchCount"`
+
+	// The users watching the issue
+	Watchers []SimpleUser `json:"watchers"`
+}
+
+// Votes represents the votes on an issue
+type Votes struct {
+	// URL of the votes resource
+	Self string `json:"self,omitempty"`
+
+	// Number of votes
+	Votes int `json:"votes"`
+
+	// Whether the current user has voted
+	HasVoted bool `json:"hasVoted"`
+
+	// The users who voted, only returned when the user has permission to view voters
+	Voters []SimpleUser `json:"voters"`
+}
+
 // PagedWorklog represents worklog information with pagination
 type PagedWorklog struct {
 	StartAt    int       `json:"startAt,omitempty"`
@@ -274,6 +411,43 @@ type Worklog struct {
 	TimeSpentSeconds int         `json:"timeSpentSeconds,omitempty"`
 	ID               string      `json:"id,omitempty"`
 	IssueID          string      `json:"issueId,omitempty"`
+	Visibility       *Visibility `json:"visibility,omitempty"`
+}
+
+// StartedTime parses the time the work was started
+func (w Worklog) StartedTime() (time.Time, error) {
+	return time.Parse(utils.JIRATIMEFORMAT, w.Started)
+}
+
+// WorklogRequest represents the request body for adding or updating a worklog
+type WorklogRequest struct {
+	// A comment about the work as an ADF document
+	Comment interface{} `json:"comment,omitempty"`
+
+	// When the work was started, sent in the Jira time format. The zero value is omitted
+	Started time.Time `json:"-"`
+
+	// The time spent in Jira duration format, e.g. "3h 20m". Either TimeSpent or TimeSpentSeconds is required when adding
+	TimeSpent string `json:"timeSpent,omitempty"`
+
+	// The time spent in seconds
+	TimeSpentSeconds int `json:"timeSpentSeconds,omitempty"`
+
+	// Restricts the worklog to a project role or group
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// MarshalJSON encodes Started in the Jira time format
+func (r WorklogRequest) MarshalJSON() ([]byte, error) {
+	type alias WorklogRequest
+	payload := struct {
+		alias
+		Started string `json:"started,omitempty"`
+	}{alias: alias(r)}
+	if !r.Started.IsZero() {
+		payload.Started = r.Started.Format(utils.JIRATIMEFORMAT)
+	}
+	return json.Marshal(payload)
 }
 
 // IssueComment represents a single comment on a Jira issue
@@ -282,12 +456,35 @@ type IssueComment struct {
 	ID           string      `json:"id"`
 	Author       SimpleUser  `json:"author"`
 	Body         interface{} `json:"body"`
+	RenderedBody string      `json:"renderedBody,omitempty"`
 	UpdateAuthor SimpleUser  `json:"updateAuthor"`
 	Created      string      `json:"created"`
 	Updated      string      `json:"updated"`
+	Visibility   *Visibility `json:"visibility,omitempty"`
 	JsdPublic    bool        `json:"jsdPublic"`
 }
 
+// CommentRequest represents the request body for adding or updating a comment
+type CommentRequest struct {
+	// The comment text as an ADF document
+	Body interface{} `json:"body"`
+
+	// Restricts the comment to a project role or group. Nil makes it visible to everyone who can see the issue
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// Visibility restricts who can see a comment or worklog
+type Visibility struct {
+	// Whether visibility is restricted by role or group, see VISIBILITY_TYPE_ROLE and VISIBILITY_TYPE_GROUP
+	Type string `json:"type"`
+
+	// The name of the project role or group
+	Value string `json:"value,omitempty"`
+
+	// The ID of the group or the project role, takes precedence over Value for groups
+	Identifier string `json:"identifier,omitempty"`
+}
+
 // PagedComment represents a paged list of comments with pagination information
 type PagedComment struct {
 	StartAt    int            `json:"startAt"`
@@ -296,12 +493,106 @@ type PagedComment struct {
 	Comments   []IssueComment `json:"comments"`
 }
 
+// Attachment represents the metadata of a file attached to an issue
+type Attachment struct {
+	Self      string     `json:"self,omitempty"`
+	ID        string     `json:"id,omitempty"`
+	Filename  string     `json:"filename,omitempty"`
+	Author    SimpleUser `json:"author,omitempty"`
+	Created   string     `json:"created,omitempty"`
+	Size      int64      `json:"size,omitempty"`
+	MimeType  string     `json:"mimeType,omitempty"`
+	Content   string     `json:"content,omitempty"`
+	Thumbnail string     `json:"thumbnail,omitempty"`
+}
+
+// IssueLinkType represents a type of link between issues, e.g. "Blocks" with the
+// outward description "blocks" and the inward description "is blocked by"
+type IssueLinkType struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Inward  string `json:"inward,omitempty"`
+	Outward string `json:"outward,omitempty"`
+	Self    string `json:"self,omitempty"`
+}
+
+// IssueLinkTypes represents the list of issue link types
+type IssueLinkTypes struct {
+	IssueLinkTypes []IssueLinkType `json:"issueLinkTypes"`
+}
+
+// IssueLink represents a link between two issues.
+// Read from an issue's fields, only the other side of the link is set: an OutwardIssue
+// means "this issue <outward> OutwardIssue", an InwardIssue means "this issue <inward> InwardIssue".
+type IssueLink struct {
+	ID           string        `json:"id,omitempty"`
+	Self         string        `json:"self,omitempty"`
+	Type         IssueLinkType `json:"type,omitempty"`
+	InwardIssue  *LinkedIssue  `json:"inwardIssue,omitempty"`
+	OutwardIssue *LinkedIssue  `json:"outwardIssue,omitempty"`
+}
+
+// LinkedIssue represents a minimal version of an issue used on either side of an issue link
+type LinkedIssue struct {
+	// The ID of the linked issue
+	ID string `json:"id,omitempty"`
+
+	// The key of the linked issue
+	Key string `json:"key,omitempty"`
+
+	// The self URL of the linked issue
+	Self string `json:"self,omitempty"`
+
+	// The fields of the linked issue
+	Fields struct {
+		// The summary of the linked issue
+		Summary string `json:"summary,omitempty"`
+
+		// The status of the linked issue
+		Status StatusDetails `json:"status,omitempty"`
+
+		// The priority of the linked issue
+		Priority Priority `json:"priority,omitempty"`
+
+		// The issue type of the linked issue
+		IssueType IssueType `json:"issuetype,omitempty"`
+	} `json:"fields,omitempty"`
+}
+
+// IssueLinkRequest represents the request body for linking two issues.
+// With the "Blocks" type, InwardIssue blocks OutwardIssue.
+type IssueLinkRequest struct {
+	// The link type, identified by ID or name
+	Type IssueLinkTypeReference `json:"type"`
+
+	// The issue on the inward side of the link, identified by ID or key
+	InwardIssue IssueReference `json:"inwardIssue"`
+
+	// The issue on the outward side of the link, identified by ID or key
+	OutwardIssue IssueReference `json:"outwardIssue"`
+
+	// An optional comment added to the outward issue
+	Comment *CommentRequest `json:"comment,omitempty"`
+}
+
+// IssueLinkTypeReference identifies an issue link type by ID or name
+type IssueLinkTypeReference struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// IssueReference identifies an issue by ID or key
+type IssueReference struct {
+	ID  string `json:"id,omitempty"`
+	Key string `json:"key,omitempty"`
+}
+
 // PageOfChangelogs represents a paged list of changelogs
 type PageOfChangelogs struct {
-	Histories  []Changelog `json:"histories,omitempty"`
-	MaxResults int         `json:"maxResults,omitempty"`
-	StartAt    int         `json:"startAt,omitempty"`
-	Total      int         `json:"total,omitempty"`
+	Histories  Changelogs `json:"histories,omitempty"`
+	MaxResults int        `json:"maxResults,omitempty"`
+	StartAt    int        `json:"startAt,omitempty"`
+	Total      int        `json:"total,omitempty"`
 }
 
 // Changelog represents a single changelog entry
@@ -312,6 +603,11 @@ type Changelog struct {
 	Items   []ChangelogDetails `json:"items,omitempty"`
 }
 
+// CreatedTime parses the time the change was made
+func (c Changelog) CreatedTime() (time.Time, error) {
+	return time.Parse(utils.JIRATIMEFORMAT, c.Created)
+}
+
 // ChangelogDetails represents the details of a changelog item
 type ChangelogDetails struct {
 	// The name of the field changed
@@ -335,3 +631,47 @@ type ChangelogDetails struct {
 	// The details of the new value as a string
 	ToString string `json:"toString,omitempty"`
 }
+
+// PagedChangelog represents a page of the changelog of an issue, oldest change first
+type PagedChangelog struct {
+	Self       string     `json:"self,omitempty"`
+	NextPage   string     `json:"nextPage,omitempty"`
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	IsLast     bool       `json:"isLast"`
+	Values     Changelogs `json:"values"`
+}
+
+// BulkChangelogRequest represents the request body for fetching the changelogs of many issues
+type BulkChangelogRequest struct {
+	// IDs or keys of the issues, at most BULK_CHANGELOG_MAX_ISSUES
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+
+	// Only return changes to these fields, e.g. "status" or "customfield_10016"
+	FieldIDs []string `json:"fieldIds,omitempty"`
+
+	// Maximum number of changelogs to return across all issues
+	MaxResults int `json:"maxResults,omitempty"`
+
+	// Token for pagination to get the next page of results
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// BulkChangelogResponse represents a page of the changelogs of many issues
+type BulkChangelogResponse struct {
+	// The changelogs, grouped by issue
+	IssueChangelogs []IssueChangelog `json:"issueChangeLogs"`
+
+	// Token to request the next page, empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// IssueChangelog represents the changelog of one issue in a bulk fetch
+type IssueChangelog struct {
+	// The ID of the issue, bulk fetches identify issues by ID even when requested by key
+	IssueID string `json:"issueId"`
+
+	// The changes of the issue, oldest first
+	ChangeHistories Changelogs `json:"changeHistories"`
+}
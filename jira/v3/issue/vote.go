@@ -0,0 +1,59 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetVotes retrieves the votes on an issue, including the voters when the user has permission to view them
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-votes/#api-rest-api-3-issue-issueidorkey-votes-get
+func (s *Service) GetVotes(ctx context.Context, issueIDOrKey string) (*Votes, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_VOTES_ENDPOINT, issueIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	votes := new(Votes)
+	if err := s.client.Do(req, votes); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return votes, nil
+}
+
+// AddVote adds the current user's vote to an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-votes/#api-rest-api-3-issue-issueidorkey-votes-post
+func (s *Service) AddVote(ctx context.Context, issueIDOrKey string) error {
+	return s.vote(ctx, http.MethodPost, issueIDOrKey)
+}
+
+// RemoveVote removes the current user's vote from an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-votes/#api-rest-api-3-issue-issueidorkey-votes-delete
+func (s *Service) RemoveVote(ctx context.Context, issueIDOrKey string) error {
+	return s.vote(ctx, http.MethodDelete, issueIDOrKey)
+}
+
+// vote adds or removes the current user's vote
+func (s *Service) vote(ctx context.Context, method, issueIDOrKey string) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_VOTES_ENDPOINT, issueIDOrKey)
+	req, err := s.client.NewRequest(ctx, method, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
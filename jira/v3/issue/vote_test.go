@@ -0,0 +1,96 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+func TestService_GetVotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/votes" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Votes{
+			Votes:    1,
+			HasVoted: true,
+			Voters:   []SimpleUser{{AccountID: "5b10ac8d82e05b22cc7d4ef5", DisplayName: "Mia Krystof"}},
+		})
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	got, err := service.GetVotes(context.Background(), "TEST-1")
+	if err != nil {
+		t.Fatalf("GetVotes() error = %v", err)
+	}
+	if got.Votes != 1 || !got.HasVoted || len(got.Voters) != 1 {
+		t.Errorf("GetVotes() = %+v", got)
+	}
+}
+
+func TestService_AddRemoveVote(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		issueKey   string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name:       "add vote",
+			method:     http.MethodPost,
+			issueKey:   "TEST-1",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "remove vote",
+			method:     http.MethodDelete,
+			issueKey:   "TEST-1",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "error - voting disabled",
+			method:     http.MethodPost,
+			issueKey:   "TEST-1",
+			statusCode: http.StatusNotFound,
+			wantErr:    true,
+		},
+		{
+			name:    "error - missing key",
+			method:  http.MethodDelete,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.method {
+					t.Errorf("Method = %v, want %v", r.Method, tt.method)
+				}
+				if r.URL.Path != "/rest/api/3/issue/TEST-1/votes" {
+					t.Errorf("Path = %v", r.URL.Path)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			var err error
+			if tt.method == http.MethodPost {
+				err = service.AddVote(context.Background(), tt.issueKey)
+			} else {
+				err = service.RemoveVote(context.Background(), tt.issueKey)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
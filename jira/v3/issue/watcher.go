@@ -0,0 +1,76 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetWatchers retrieves the users watching an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-watchers/#api-rest-api-3-issue-issueidorkey-watchers-get
+func (s *Service) GetWatchers(ctx context.Context, issueIDOrKey string) (*Watchers, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_WATCHERS_ENDPOINT, issueIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	watchers := new(Watchers)
+	if err := s.client.Do(req, watchers); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return watchers, nil
+}
+
+// AddWatcher adds a user as a watcher of an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-watchers/#api-rest-api-3-issue-issueidorkey-watchers-post
+func (s *Service) AddWatcher(ctx context.Context, issueIDOrKey, accountID string) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+	if accountID == "" {
+		return fmt.Errorf("account ID is required")
+	}
+
+	// The body is the account ID as a bare JSON string
+	path := fmt.Sprintf(ISSUE_WATCHERS_ENDPOINT, issueIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, accountID)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveWatcher removes a user from the watchers of an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-watchers/#api-rest-api-3-issue-issueidorkey-watchers-delete
+func (s *Service) RemoveWatcher(ctx context.Context, issueIDOrKey, accountID string) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+	if accountID == "" {
+		return fmt.Errorf("account ID is required")
+	}
+
+	path := fmt.Sprintf("%s?accountId=%s", fmt.Sprintf(ISSUE_WATCHERS_ENDPOINT, issueIDOrKey), url.QueryEscape(accountID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+func TestService_GetWatchers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/watchers" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Watchers{
+			IsWatching: true,
+			WatchCount: 2,
+			Watchers: []SimpleUser{
+				{AccountID: "5b10a2844c20165700ede21g", DisplayName: "Mia Krystof", Active: true},
+				{AccountID: "5b10ac8d82e05b22cc7d4ef5", DisplayName: "Emma Richards", Active: true},
+			},
+		})
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	got, err := service.GetWatchers(context.Background(), "TEST-1")
+	if err != nil {
+		t.Fatalf("GetWatchers() error = %v", err)
+	}
+	if got.WatchCount != 2 || len(got.Watchers) != 2 || got.Watchers[1].DisplayName != "Emma Richards" {
+		t.Errorf("GetWatchers() = %+v", got)
+	}
+
+	if _, err := service.GetWatchers(context.Background(), ""); err == nil {
+		t.Error("GetWatchers() expected error for missing key, got nil")
+	}
+}
+
+func TestService_AddWatcher(t *testing.T) {
+	tests := []struct {
+		name       string
+		issueKey   string
+		accountID  string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name:       "success",
+			issueKey:   "TEST-1",
+			accountID:  "5b10ac8d82e05b22cc7d4ef5",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "error - user not found",
+			issueKey:   "TEST-1",
+			accountID:  "unknown",
+			statusCode: http.StatusNotFound,
+			wantErr:    true,
+		},
+		{
+			name:     "error - missing account ID",
+			issueKey: "TEST-1",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want POST", r.Method)
+				}
+				if r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("Content-Type = %v, want application/json", r.Header.Get("Content-Type"))
+				}
+				body, _ := io.ReadAll(r.Body)
+				if want := `"` + tt.accountID + `"`; strings.TrimSpace(string(body)) != want {
+					t.Errorf("Body = %s, want %s", body, want)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			err := service.AddWatcher(context.Background(), tt.issueKey, tt.accountID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddWatcher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_RemoveWatcher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Method = %v, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/watchers" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		if r.URL.Query().Get("accountId") != "5b10ac8d82e05b22cc7d4ef5" {
+			t.Errorf("accountId = %v", r.URL.Query().Get("accountId"))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	if err := service.RemoveWatcher(context.Background(), "TEST-1", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("RemoveWatcher() error = %v", err)
+	}
+	if err := service.RemoveWatcher(context.Background(), "TEST-1", ""); err == nil {
+		t.Error("RemoveWatcher() expected error for missing account ID, got nil")
+	}
+}
@@ -0,0 +1,250 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListWorklogs retrieves a page of worklogs for an issue, ordered by creation time
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-issue-issueidorkey-worklog-get
+func (s *Service) ListWorklogs(ctx context.Context, issueIDOrKey string, opts IssueWorklogOpts) (*PagedWorklog, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_WORKLOG_ENDPOINT, issueIDOrKey)
+	params := url.Values{}
+
+	if opts.StartAt > 0 {
+		params.Add("startAt", strconv.Itoa(opts.StartAt))
+	}
+	if opts.MaxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(opts.MaxResults))
+	}
+	if !opts.StartedAfter.IsZero() {
+		params.Add("startedAfter", strconv.FormatInt(opts.StartedAfter.UnixMilli(), 10))
+	}
+	if !opts.StartedBefore.IsZero() {
+		params.Add("startedBefore", strconv.FormatInt(opts.StartedBefore.UnixMilli(), 10))
+	}
+	if len(opts.Expand) > 0 {
+		params.Add("expand", strings.Join(opts.Expand, ","))
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	worklogs := new(PagedWorklog)
+	if err := s.client.Do(req, worklogs); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return worklogs, nil
+}
+
+// ListAllWorklogs retrieves every worklog of an issue, going past the limit of worklogs embedded in the issue.
+// Pages of opts.MaxResults worklogs are requested starting at opts.StartAt until the total is reached.
+func (s *Service) ListAllWorklogs(ctx context.Context, issueIDOrKey string, opts IssueWorklogOpts) ([]Worklog, error) {
+	var worklogs []Worklog
+	for {
+		page, err := s.ListWorklogs(ctx, issueIDOrKey, opts)
+		if err != nil {
+			return worklogs, err
+		}
+
+		worklogs = append(worklogs, page.Worklogs...)
+		opts.StartAt += len(page.Worklogs)
+		if len(page.Worklogs) == 0 || opts.StartAt >= page.Total {
+			return worklogs, nil
+		}
+	}
+}
+
+// GetWorklog retrieves a worklog of an issue
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-issue-issueidorkey-worklog-id-get
+func (s *Service) GetWorklog(ctx context.Context, issueIDOrKey, worklogID string, expand []string) (*Worklog, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+	if worklogID == "" {
+		return nil, fmt.Errorf("worklog ID is required")
+	}
+
+	path := fmt.Sprintf(ISSUE_WORKLOG_DETAIL_ENDPOINT, issueIDOrKey, worklogID)
+	if len(expand) > 0 {
+		path = fmt.Sprintf("%s?expand=%s", path, url.QueryEscape(strings.Join(expand, ",")))
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	worklog := new(Worklog)
+	if err := s.client.Do(req, worklog); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return worklog, nil
+}
+
+// AddWorklog logs time against an issue. With ADJUST_ESTIMATE_MANUAL, opts.ReduceBy is required.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-issue-issueidorkey-worklog-post
+func (s *Service) AddWorklog(ctx context.Context, issueIDOrKey string, request WorklogRequest, opts IssueWorklogWriteOpts) (*Worklog, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+	if request.TimeSpent == "" && request.TimeSpentSeconds <= 0 {
+		return nil, fmt.Errorf("time spent is required")
+	}
+
+	params, err := worklogWriteParams(opts, "reduceBy", opts.ReduceBy)
+	if err != nil {
+		return nil, err
+	}
+	addWorklogExpand(params, opts)
+	if err := s.checkADF(request); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(ISSUE_WORKLOG_ENDPOINT, issueIDOrKey)
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	worklog := new(Worklog)
+	if err := s.client.Do(req, worklog); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return worklog, nil
+}
+
+// UpdateWorklog updates a worklog. Zero fields of the request are left unchanged.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-issue-issueidorkey-worklog-id-put
+func (s *Service) UpdateWorklog(ctx context.Context, issueIDOrKey, worklogID string, request WorklogRequest, opts IssueWorklogWriteOpts) (*Worklog, error) {
+	if issueIDOrKey == "" {
+		return nil, fmt.Errorf("issue ID or key is required")
+	}
+	if worklogID == "" {
+		return nil, fmt.Errorf("worklog ID is required")
+	}
+
+	params, err := worklogWriteParams(opts, "", "")
+	if err != nil {
+		return nil, err
+	}
+	addWorklogExpand(params, opts)
+	if err := s.checkADF(request); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf(ISSUE_WORKLOG_DETAIL_ENDPOINT, issueIDOrKey, worklogID)
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	worklog := new(Worklog)
+	if err := s.client.Do(req, worklog); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return worklog, nil
+}
+
+// DeleteWorklog deletes a worklog. With ADJUST_ESTIMATE_MANUAL, opts.IncreaseBy is required.
+// opts.Expand is ignored since no worklog is returned.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-issue-issueidorkey-worklog-id-delete
+func (s *Service) DeleteWorklog(ctx context.Context, issueIDOrKey, worklogID string, opts IssueWorklogWriteOpts) error {
+	if issueIDOrKey == "" {
+		return fmt.Errorf("issue ID or key is required")
+	}
+	if worklogID == "" {
+		return fmt.Errorf("worklog ID is required")
+	}
+
+	params, err := worklogWriteParams(opts, "increaseBy", opts.IncreaseBy)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf(ISSUE_WORKLOG_DETAIL_ENDPOINT, issueIDOrKey, worklogID)
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// worklogWriteParams builds the query parameters shared by the worklog write operations.
+// manualParam is the parameter carrying the manual adjustment, empty when the operation has none.
+func worklogWriteParams(opts IssueWorklogWriteOpts, manualParam, manualValue string) (url.Values, error) {
+	params := url.Values{}
+
+	switch opts.AdjustEstimate {
+	case "", ADJUST_ESTIMATE_AUTO, ADJUST_ESTIMATE_LEAVE:
+	case ADJUST_ESTIMATE_NEW:
+		if opts.NewEstimate == "" {
+			return nil, fmt.Errorf("new estimate is required when adjusting the estimate to a new value")
+		}
+		params.Add("newEstimate", opts.NewEstimate)
+	case ADJUST_ESTIMATE_MANUAL:
+		if manualParam == "" {
+			return nil, fmt.Errorf("manual estimate adjustment is not supported by this operation")
+		}
+		if manualValue == "" {
+			return nil, fmt.Errorf("%s is required when adjusting the estimate manually", manualParam)
+		}
+		params.Add(manualParam, manualValue)
+	default:
+		return nil, fmt.Errorf("invalid estimate adjustment %q", opts.AdjustEstimate)
+	}
+
+	if opts.AdjustEstimate != "" {
+		params.Add("adjustEstimate", opts.AdjustEstimate)
+	}
+	if opts.NotifyUsers != nil {
+		params.Add("notifyUsers", strconv.FormatBool(*opts.NotifyUsers))
+	}
+	if opts.OverrideEditableFlag {
+		params.Add("overrideEditableFlag", "true")
+	}
+
+	return params, nil
+}
+
+// addWorklogExpand adds the expand options of the operations returning the written worklog
+func addWorklogExpand(params url.Values, opts IssueWorklogWriteOpts) {
+	if len(opts.Expand) > 0 {
+		params.Add("expand", strings.Join(opts.Expand, ","))
+	}
+}
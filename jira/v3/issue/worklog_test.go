@@ -0,0 +1,311 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/utils"
+)
+
+func TestWorklogRequest_MarshalJSON(t *testing.T) {
+	started := time.Date(2024, 5, 14, 9, 30, 0, 0, time.FixedZone("ICT", 7*60*60))
+
+	tests := []struct {
+		name    string
+		request WorklogRequest
+		want    string
+	}{
+		{
+			name:    "with started",
+			request: WorklogRequest{Started: started, TimeSpent: "1h 30m"},
+			want:    `{"timeSpent":"1h 30m","started":"2024-05-14T09:30:00.000+0700"}`,
+		},
+		{
+			name:    "zero started is omitted",
+			request: WorklogRequest{TimeSpentSeconds: 3600, Visibility: &Visibility{Type: VISIBILITY_TYPE_GROUP, Value: "jira-developers"}},
+			want:    `{"timeSpentSeconds":3600,"visibility":{"type":"group","value":"jira-developers"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.request)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorklog_StartedTime(t *testing.T) {
+	w := Worklog{Started: "2024-05-14T09:30:00.000+0700"}
+	got, err := w.StartedTime()
+	if err != nil {
+		t.Fatalf("StartedTime() error = %v", err)
+	}
+	if !got.Equal(time.Date(2024, 5, 14, 2, 30, 0, 0, time.UTC)) {
+		t.Errorf("StartedTime() = %v", got)
+	}
+}
+
+func TestService_ListAllWorklogs(t *testing.T) {
+	const total = 5
+	after := time.UnixMilli(1715650000000)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/worklog" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		if r.URL.Query().Get("startedAfter") != "1715650000000" {
+			t.Errorf("startedAfter = %v, want 1715650000000", r.URL.Query().Get("startedAfter"))
+		}
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+
+		page := PagedWorklog{StartAt: startAt, MaxResults: maxResults, Total: total}
+		for i := startAt; i < min(startAt+maxResults, total); i++ {
+			page.Worklogs = append(page.Worklogs, Worklog{ID: fmt.Sprint(i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	worklogs, err := service.ListAllWorklogs(context.Background(), "TEST-1", IssueWorklogOpts{MaxResults: 2, StartedAfter: after})
+	if err != nil {
+		t.Fatalf("ListAllWorklogs() error = %v", err)
+	}
+	if len(worklogs) != total {
+		t.Errorf("ListAllWorklogs() returned %d worklogs, want %d", len(worklogs), total)
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3", requests)
+	}
+
+	if _, err := service.ListWorklogs(context.Background(), "", IssueWorklogOpts{}); err == nil {
+		t.Error("ListWorklogs() expected error for missing key, got nil")
+	}
+}
+
+func TestService_GetWorklog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/worklog/100" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Worklog{ID: "100", TimeSpentSeconds: 3600})
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	got, err := service.GetWorklog(context.Background(), "TEST-1", "100", nil)
+	if err != nil {
+		t.Fatalf("GetWorklog() error = %v", err)
+	}
+	if got.TimeSpentSeconds != 3600 {
+		t.Errorf("GetWorklog() TimeSpentSeconds = %v, want 3600", got.TimeSpentSeconds)
+	}
+}
+
+func TestService_AddWorklog(t *testing.T) {
+	started := time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		request  WorklogRequest
+		opts     IssueWorklogWriteOpts
+		wantURL  string
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name:     "success - auto estimate",
+			request:  WorklogRequest{Started: started, TimeSpent: "2h"},
+			wantURL:  "/rest/api/3/issue/TEST-1/worklog",
+			wantBody: `{"timeSpent":"2h","started":"2024-05-14T09:00:00.000+0000"}`,
+		},
+		{
+			name:     "success - new estimate",
+			request:  WorklogRequest{TimeSpent: "2h"},
+			opts:     IssueWorklogWriteOpts{AdjustEstimate: ADJUST_ESTIMATE_NEW, NewEstimate: "1d"},
+			wantURL:  "/rest/api/3/issue/TEST-1/worklog?adjustEstimate=new&newEstimate=1d",
+			wantBody: `{"timeSpent":"2h"}`,
+		},
+		{
+			name:     "success - manual estimate",
+			request:  WorklogRequest{TimeSpentSeconds: 1800},
+			opts:     IssueWorklogWriteOpts{AdjustEstimate: ADJUST_ESTIMATE_MANUAL, ReduceBy: "1h", NotifyUsers: utils.Bool(false)},
+			wantURL:  "/rest/api/3/issue/TEST-1/worklog?adjustEstimate=manual&notifyUsers=false&reduceBy=1h",
+			wantBody: `{"timeSpentSeconds":1800}`,
+		},
+		{
+			name:     "success - leave estimate",
+			request:  WorklogRequest{TimeSpent: "15m"},
+			opts:     IssueWorklogWriteOpts{AdjustEstimate: ADJUST_ESTIMATE_LEAVE},
+			wantURL:  "/rest/api/3/issue/TEST-1/worklog?adjustEstimate=leave",
+			wantBody: `{"timeSpent":"15m"}`,
+		},
+		{
+			name:    "error - new estimate missing",
+			request: WorklogRequest{TimeSpent: "2h"},
+			opts:    IssueWorklogWriteOpts{AdjustEstimate: ADJUST_ESTIMATE_NEW},
+			wantErr: true,
+		},
+		{
+			name:    "error - reduce by missing",
+			request: WorklogRequest{TimeSpent: "2h"},
+			opts:    IssueWorklogWriteOpts{AdjustEstimate: ADJUST_ESTIMATE_MANUAL, IncreaseBy: "1h"},
+			wantErr: true,
+		},
+		{
+			name:    "error - invalid mode",
+			request: WorklogRequest{TimeSpent: "2h"},
+			opts:    IssueWorklogWriteOpts{AdjustEstimate: "sometimes"},
+			wantErr: true,
+		},
+		{
+			name:    "error - time spent missing",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want POST", r.Method)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if strings.TrimSpace(string(body)) != tt.wantBody {
+					t.Errorf("Body = %s, want %s", body, tt.wantBody)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(Worklog{ID: "100"})
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			got, err := service.AddWorklog(context.Background(), "TEST-1", tt.request, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddWorklog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.ID != "100" {
+				t.Errorf("AddWorklog() ID = %v, want 100", got.ID)
+			}
+		})
+	}
+}
+
+func TestService_UpdateWorklog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Method = %v, want PUT", r.Method)
+		}
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/worklog/100" {
+			t.Errorf("Path = %v", r.URL.Path)
+		}
+		if r.URL.RawQuery != "adjustEstimate=new&newEstimate=4h" {
+			t.Errorf("Query = %v", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Worklog{ID: "100", TimeSpent: "3h"})
+	}))
+	defer server.Close()
+
+	service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+	got, err := service.UpdateWorklog(context.Background(), "TEST-1", "100", WorklogRequest{TimeSpent: "3h"}, IssueWorklogWriteOpts{
+		AdjustEstimate: ADJUST_ESTIMATE_NEW,
+		NewEstimate:    "4h",
+	})
+	if err != nil {
+		t.Fatalf("UpdateWorklog() error = %v", err)
+	}
+	if got.TimeSpent != "3h" {
+		t.Errorf("UpdateWorklog() TimeSpent = %v, want 3h", got.TimeSpent)
+	}
+
+	_, err = service.UpdateWorklog(context.Background(), "TEST-1", "100", WorklogRequest{TimeSpent: "3h"}, IssueWorklogWriteOpts{
+		AdjustEstimate: ADJUST_ESTIMATE_MANUAL,
+		ReduceBy:       "1h",
+	})
+	if err == nil {
+		t.Error("UpdateWorklog() expected error for manual adjustment, got nil")
+	}
+}
+
+func TestService_DeleteWorklog(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    IssueWorklogWriteOpts
+		wantURL string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			wantURL: "/rest/api/3/issue/TEST-1/worklog/100",
+		},
+		{
+			name:    "success - manual increase",
+			opts:    IssueWorklogWriteOpts{AdjustEstimate: ADJUST_ESTIMATE_MANUAL, IncreaseBy: "2h"},
+			wantURL: "/rest/api/3/issue/TEST-1/worklog/100?adjustEstimate=manual&increaseBy=2h",
+		},
+		{
+			name:    "success - expand is not sent",
+			opts:    IssueWorklogWriteOpts{Expand: []string{"properties"}, NotifyUsers: utils.Bool(false)},
+			wantURL: "/rest/api/3/issue/TEST-1/worklog/100?notifyUsers=false",
+		},
+		{
+			name:    "error - increase by missing",
+			opts:    IssueWorklogWriteOpts{AdjustEstimate: ADJUST_ESTIMATE_MANUAL, ReduceBy: "2h"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL := r.URL.Path
+				if r.URL.RawQuery != "" {
+					gotURL += "?" + r.URL.RawQuery
+				}
+				if gotURL != tt.wantURL {
+					t.Errorf("URL = %v, want %v", gotURL, tt.wantURL)
+				}
+				if r.Method != http.MethodDelete {
+					t.Errorf("Method = %v, want DELETE", r.Method)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			service := NewService(nil, server.URL, auth.NewBasicAuth("test", "test"))
+			err := service.DeleteWorklog(context.Background(), "TEST-1", "100", tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteWorklog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
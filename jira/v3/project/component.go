@@ -0,0 +1,221 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// ComponentUpdateRequest holds the changes to a component for UpdateComponent, nil fields are left unchanged
+type ComponentUpdateRequest struct {
+	// The new name of the component
+	Name *string `json:"name,omitempty"`
+
+	// The new description of the component, "" clears it
+	Description *string `json:"description,omitempty"`
+
+	// The account ID of the new lead of the component, "" removes the lead
+	LeadAccountID *string `json:"leadAccountId,omitempty"`
+
+	// The new assignee type, one of the COMPONENT_ASSIGNEE_* constants
+	AssigneeType *string `json:"assigneeType,omitempty"`
+}
+
+// componentAssigneeTypes are the values accepted as the assignee type of a component
+var componentAssigneeTypes = []string{
+	COMPONENT_ASSIGNEE_PROJECT_DEFAULT,
+	COMPONENT_ASSIGNEE_COMPONENT_LEAD,
+	COMPONENT_ASSIGNEE_PROJECT_LEAD,
+	COMPONENT_ASSIGNEE_UNASSIGNED,
+}
+
+// ListComponents returns a page of the components of a project, with the number of issues each is set on
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-component-get
+func (s *Service) ListComponents(ctx context.Context, projectIDOrKey string, opts ComponentListOpts) (*responsetypes.ProjectComponentListResponse, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+
+	path := fmt.Sprintf(PROJECT_COMPONENTS_PAGED_ENDPOINT, projectIDOrKey)
+	params := url.Values{}
+
+	if opts.StartAt > 0 {
+		params.Add("startAt", strconv.Itoa(opts.StartAt))
+	}
+	if opts.MaxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(opts.MaxResults))
+	}
+	if opts.OrderBy != "" {
+		params.Add("orderBy", opts.OrderBy)
+	}
+	if opts.Query != "" {
+		params.Add("query", opts.Query)
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	response := new(responsetypes.ProjectComponentListResponse)
+	if err := s.client.Do(req, response); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return response, nil
+}
+
+// ListAllComponents returns every component of a project in one request, without issue counts
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-components-get
+func (s *Service) ListAllComponents(ctx context.Context, projectIDOrKey string) ([]responsetypes.ProjectComponent, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(PROJECT_COMPONENTS_ENDPOINT, projectIDOrKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	var components []responsetypes.ProjectComponent
+	if err := s.client.Do(req, &components); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return components, nil
+}
+
+// GetComponent returns a component
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-get
+func (s *Service) GetComponent(ctx context.Context, componentID string) (*responsetypes.ProjectComponent, error) {
+	if componentID == "" {
+		return nil, fmt.Errorf("component ID is required")
+	}
+
+	return s.requestComponent(ctx, http.MethodGet, fmt.Sprintf(COMPONENT_DETAIL_ENDPOINT, componentID), nil)
+}
+
+// CreateComponent creates a component in the project set by component.Project.
+// The lead is taken from component.LeadAccountID, or from component.Lead when no account ID is set.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-post
+func (s *Service) CreateComponent(ctx context.Context, component *responsetypes.ProjectComponent) (*responsetypes.ProjectComponent, error) {
+	if component == nil || component.Name == "" {
+		return nil, fmt.Errorf("component name is required")
+	}
+	if component.Project == "" {
+		return nil, fmt.Errorf("project key is required")
+	}
+
+	payload, err := componentPayload(component)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.requestComponent(ctx, http.MethodPost, COMPONENT_CREATE_ENDPOINT, payload)
+}
+
+// UpdateComponent updates a component. Only the fields set in update are sent,
+// so a pointer to "" clears the description or removes the lead, e.g. utils.String("").
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-put
+func (s *Service) UpdateComponent(ctx context.Context, componentID string, update ComponentUpdateRequest) (*responsetypes.ProjectComponent, error) {
+	if componentID == "" {
+		return nil, fmt.Errorf("component ID is required")
+	}
+	if update.Name != nil && *update.Name == "" {
+		return nil, fmt.Errorf("component name can't be empty")
+	}
+	if update.AssigneeType != nil && !slices.Contains(componentAssigneeTypes, *update.AssigneeType) {
+		return nil, fmt.Errorf("invalid assignee type %q", *update.AssigneeType)
+	}
+
+	return s.requestComponent(ctx, http.MethodPut, fmt.Sprintf(COMPONENT_DETAIL_ENDPOINT, componentID), update)
+}
+
+// DeleteComponent deletes a component. When moveIssuesTo is set, the issues the component is set on
+// get the component with that ID instead.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-delete
+func (s *Service) DeleteComponent(ctx context.Context, componentID, moveIssuesTo string) error {
+	if componentID == "" {
+		return fmt.Errorf("component ID is required")
+	}
+
+	path := fmt.Sprintf(COMPONENT_DETAIL_ENDPOINT, componentID)
+	if moveIssuesTo != "" {
+		path = fmt.Sprintf("%s?moveIssuesTo=%s", path, url.QueryEscape(moveIssuesTo))
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// GetComponentIssueCount returns the number of issues a component is set on
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-relatedissuecounts-get
+func (s *Service) GetComponentIssueCount(ctx context.Context, componentID string) (*responsetypes.ComponentIssueCount, error) {
+	if componentID == "" {
+		return nil, fmt.Errorf("component ID is required")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(COMPONENT_RELATED_ISSUE_COUNTS_ENDPOINT, componentID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	count := new(responsetypes.ComponentIssueCount)
+	if err := s.client.Do(req, count); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return count, nil
+}
+
+// requestComponent sends a request with an optional component payload and decodes the component in the response
+func (s *Service) requestComponent(ctx context.Context, method, path string, payload interface{}) (*responsetypes.ProjectComponent, error) {
+	req, err := s.client.NewRequest(ctx, method, path, payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	component := new(responsetypes.ProjectComponent)
+	if err := s.client.Do(req, component); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return component, nil
+}
+
+// componentPayload returns the writable fields of a component, with the lead given as an account ID
+func componentPayload(component *responsetypes.ProjectComponent) (*responsetypes.ProjectComponent, error) {
+	if component.AssigneeType != "" && !slices.Contains(componentAssigneeTypes, component.AssigneeType) {
+		return nil, fmt.Errorf("invalid assignee type %q", component.AssigneeType)
+	}
+
+	payload := &responsetypes.ProjectComponent{
+		Name:          component.Name,
+		Description:   component.Description,
+		LeadAccountID: component.LeadAccountID,
+		AssigneeType:  component.AssigneeType,
+		Project:       component.Project,
+	}
+	if payload.LeadAccountID == "" && component.Lead != nil {
+		payload.LeadAccountID = component.Lead.AccountID
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,367 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+	"github.com/ducminhgd/go-atlassian/jira/v3/utils"
+)
+
+func TestListComponents(t *testing.T) {
+	tests := []struct {
+		name           string
+		projectIDOrKey string
+		opts           ComponentListOpts
+		wantURL        string
+		wantErr        bool
+		statusCode     int
+	}{
+		{
+			name:           "no options",
+			projectIDOrKey: "TEST",
+			wantURL:        "/rest/api/3/project/TEST/component",
+			statusCode:     http.StatusOK,
+		},
+		{
+			name:           "with options",
+			projectIDOrKey: "TEST",
+			opts:           ComponentListOpts{StartAt: 50, MaxResults: 50, OrderBy: "-issueCount", Query: "api"},
+			wantURL:        "/rest/api/3/project/TEST/component?maxResults=50&orderBy=-issueCount&query=api&startAt=50",
+			statusCode:     http.StatusOK,
+		},
+		{
+			name:           "not found",
+			projectIDOrKey: "NOPE",
+			wantURL:        "/rest/api/3/project/NOPE/component",
+			wantErr:        true,
+			statusCode:     http.StatusNotFound,
+		},
+		{
+			name:    "missing project",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := responsetypes.ProjectComponentListResponse{
+				Total:  1,
+				IsLast: true,
+				Values: []responsetypes.ProjectComponentWithIssueCount{
+					{ProjectComponent: responsetypes.ProjectComponent{ID: "10000", Name: "API"}, IssueCount: 12},
+				},
+			}
+			service, done := newTestService(t, http.MethodGet, tt.wantURL, tt.statusCode, response, nil)
+			defer done()
+
+			page, err := service.ListComponents(context.Background(), tt.projectIDOrKey, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListComponents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(page.Values) != 1 || page.Values[0].Name != "API" || page.Values[0].IssueCount != 12) {
+				t.Errorf("ListComponents() values = %+v, want component API with 12 issues", page.Values)
+			}
+		})
+	}
+}
+
+func TestListAllComponents(t *testing.T) {
+	components := []responsetypes.ProjectComponent{{ID: "10000", Name: "API"}, {ID: "10001", Name: "UI"}}
+	service, done := newTestService(t, http.MethodGet, "/rest/api/3/project/TEST/components", http.StatusOK, components, nil)
+	defer done()
+
+	got, err := service.ListAllComponents(context.Background(), "TEST")
+	if err != nil {
+		t.Fatalf("ListAllComponents() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, components) {
+		t.Errorf("ListAllComponents() = %+v, want %+v", got, components)
+	}
+
+	if _, err := service.ListAllComponents(context.Background(), ""); err == nil {
+		t.Error("ListAllComponents() without project error = nil, want error")
+	}
+}
+
+func TestGetComponent(t *testing.T) {
+	tests := []struct {
+		name        string
+		componentID string
+		wantErr     bool
+		statusCode  int
+	}{
+		{
+			name:        "success",
+			componentID: "10000",
+			statusCode:  http.StatusOK,
+		},
+		{
+			name:        "not found",
+			componentID: "10000",
+			wantErr:     true,
+			statusCode:  http.StatusNotFound,
+		},
+		{
+			name:    "missing component ID",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := responsetypes.ProjectComponent{
+				ID:                  "10000",
+				Name:                "API",
+				AssigneeType:        COMPONENT_ASSIGNEE_COMPONENT_LEAD,
+				Lead:                &responsetypes.User{AccountID: "lead-1"},
+				RealAssignee:        &responsetypes.User{AccountID: "lead-1"},
+				IsAssigneeTypeValid: true,
+			}
+			service, done := newTestService(t, http.MethodGet, "/rest/api/3/component/10000", tt.statusCode, response, nil)
+			defer done()
+
+			component, err := service.GetComponent(context.Background(), tt.componentID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetComponent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (component.Lead == nil || component.Lead.AccountID != "lead-1" || !component.IsAssigneeTypeValid) {
+				t.Errorf("GetComponent() = %+v, want lead lead-1", component)
+			}
+		})
+	}
+}
+
+func TestCreateComponent(t *testing.T) {
+	tests := []struct {
+		name       string
+		component  *responsetypes.ProjectComponent
+		wantBody   map[string]interface{}
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name: "lead account ID",
+			component: &responsetypes.ProjectComponent{
+				Name:          "API",
+				Project:       "TEST",
+				LeadAccountID: "lead-1",
+				AssigneeType:  COMPONENT_ASSIGNEE_COMPONENT_LEAD,
+			},
+			wantBody: map[string]interface{}{
+				"name":          "API",
+				"project":       "TEST",
+				"leadAccountId": "lead-1",
+				"assigneeType":  "COMPONENT_LEAD",
+			},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name: "lead user",
+			component: &responsetypes.ProjectComponent{
+				ID:          "ignored",
+				Name:        "API",
+				Description: "Public API",
+				Project:     "TEST",
+				Lead:        &responsetypes.User{AccountID: "lead-2", DisplayName: "Lead"},
+			},
+			wantBody: map[string]interface{}{
+				"name":          "API",
+				"description":   "Public API",
+				"project":       "TEST",
+				"leadAccountId": "lead-2",
+			},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:      "invalid assignee type",
+			component: &responsetypes.ProjectComponent{Name: "API", Project: "TEST", AssigneeType: "LEAD"},
+			wantErr:   true,
+		},
+		{
+			name:      "missing project",
+			component: &responsetypes.ProjectComponent{Name: "API"},
+			wantErr:   true,
+		},
+		{
+			name:      "missing name",
+			component: &responsetypes.ProjectComponent{Project: "TEST"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(t *testing.T, body map[string]interface{}) {
+				if !reflect.DeepEqual(body, tt.wantBody) {
+					t.Errorf("body = %v, want %v", body, tt.wantBody)
+				}
+			}
+			service, done := newTestService(t, http.MethodPost, "/rest/api/3/component", tt.statusCode, responsetypes.ProjectComponent{ID: "10000", Name: "API"}, check)
+			defer done()
+
+			component, err := service.CreateComponent(context.Background(), tt.component)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateComponent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && component.ID != "10000" {
+				t.Errorf("CreateComponent() ID = %v, want 10000", component.ID)
+			}
+		})
+	}
+}
+
+func TestUpdateComponent(t *testing.T) {
+	tests := []struct {
+		name        string
+		componentID string
+		update      ComponentUpdateRequest
+		wantBody    map[string]interface{}
+		wantErr     bool
+	}{
+		{
+			name:        "description and assignee type",
+			componentID: "10000",
+			update:      ComponentUpdateRequest{Description: utils.String("Internal API"), AssigneeType: utils.String(COMPONENT_ASSIGNEE_UNASSIGNED)},
+			wantBody:    map[string]interface{}{"description": "Internal API", "assigneeType": "UNASSIGNED"},
+		},
+		{
+			name:        "clear description",
+			componentID: "10000",
+			update:      ComponentUpdateRequest{Description: utils.String("")},
+			wantBody:    map[string]interface{}{"description": ""},
+		},
+		{
+			name:        "remove lead",
+			componentID: "10000",
+			update:      ComponentUpdateRequest{LeadAccountID: utils.String("")},
+			wantBody:    map[string]interface{}{"leadAccountId": ""},
+		},
+		{
+			name:        "reset assignee type",
+			componentID: "10000",
+			update:      ComponentUpdateRequest{Name: utils.String("API"), AssigneeType: utils.String(COMPONENT_ASSIGNEE_PROJECT_DEFAULT)},
+			wantBody:    map[string]interface{}{"name": "API", "assigneeType": "PROJECT_DEFAULT"},
+		},
+		{
+			name:        "invalid assignee type",
+			componentID: "10000",
+			update:      ComponentUpdateRequest{AssigneeType: utils.String("")},
+			wantErr:     true,
+		},
+		{
+			name:        "empty name",
+			componentID: "10000",
+			update:      ComponentUpdateRequest{Name: utils.String("")},
+			wantErr:     true,
+		},
+		{
+			name:    "missing component ID",
+			update:  ComponentUpdateRequest{Name: utils.String("API")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(t *testing.T, body map[string]interface{}) {
+				if !reflect.DeepEqual(body, tt.wantBody) {
+					t.Errorf("body = %v, want %v", body, tt.wantBody)
+				}
+			}
+			service, done := newTestService(t, http.MethodPut, "/rest/api/3/component/10000", http.StatusOK, responsetypes.ProjectComponent{ID: "10000"}, check)
+			defer done()
+
+			component, err := service.UpdateComponent(context.Background(), tt.componentID, tt.update)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdateComponent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && component.ID != "10000" {
+				t.Errorf("UpdateComponent() ID = %v, want 10000", component.ID)
+			}
+		})
+	}
+}
+
+func TestDeleteComponent(t *testing.T) {
+	tests := []struct {
+		name         string
+		componentID  string
+		moveIssuesTo string
+		wantURL      string
+		wantErr      bool
+		statusCode   int
+	}{
+		{
+			name:        "delete",
+			componentID: "10000",
+			wantURL:     "/rest/api/3/component/10000",
+			statusCode:  http.StatusNoContent,
+		},
+		{
+			name:         "move issues",
+			componentID:  "10000",
+			moveIssuesTo: "10001",
+			wantURL:      "/rest/api/3/component/10000?moveIssuesTo=10001",
+			statusCode:   http.StatusNoContent,
+		},
+		{
+			name:        "not found",
+			componentID: "10000",
+			wantURL:     "/rest/api/3/component/10000",
+			wantErr:     true,
+			statusCode:  http.StatusNotFound,
+		},
+		{
+			name:    "missing component ID",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.RequestURI() != tt.wantURL {
+					t.Errorf("request = %s %s, want DELETE %s", r.Method, r.URL.RequestURI(), tt.wantURL)
+				}
+				if body, _ := io.ReadAll(r.Body); len(body) > 0 {
+					t.Errorf("body = %s, want none", body)
+				}
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode >= 400 {
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{"errorMessages": []string{"Component not found"}})
+				}
+			}))
+			defer server.Close()
+
+			service := NewService(&http.Client{}, server.URL, auth.NewBasicAuth("testuser", "secret123"))
+			err := service.DeleteComponent(context.Background(), tt.componentID, tt.moveIssuesTo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteComponent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetComponentIssueCount(t *testing.T) {
+	service, done := newTestService(t, http.MethodGet, "/rest/api/3/component/10000/relatedIssueCounts", http.StatusOK, responsetypes.ComponentIssueCount{IssueCount: 23}, nil)
+	defer done()
+
+	count, err := service.GetComponentIssueCount(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("GetComponentIssueCount() error = %v", err)
+	}
+	if count.IssueCount != 23 {
+		t.Errorf("GetComponentIssueCount() = %d, want 23", count.IssueCount)
+	}
+
+	if _, err := service.GetComponentIssueCount(context.Background(), ""); err == nil {
+		t.Error("GetComponentIssueCount() without ID error = nil, want error")
+	}
+}
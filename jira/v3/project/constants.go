@@ -8,4 +8,81 @@ const (
 	PROJECT_SEARCH_ENDPOINT  = "/rest/api/3/project/search?%s"
 	PROJECT_RECENT_ENDPOINT  = "/rest/api/3/project/recent"
 	PROJECT_STATUS_ENDPOINT  = "/rest/api/3/project/%s/statuses"
+
+	// Project versions
+	PROJECT_VERSIONS_ENDPOINT               = "/rest/api/3/project/%s/version"
+	VERSION_CREATE_ENDPOINT                 = "/rest/api/3/version"
+	VERSION_DETAIL_ENDPOINT                 = "/rest/api/3/version/%s"
+	VERSION_MERGE_ENDPOINT                  = "/rest/api/3/version/%s/mergeto/%s"
+	VERSION_MOVE_ENDPOINT                   = "/rest/api/3/version/%s/move"
+	VERSION_REMOVE_AND_SWAP_ENDPOINT        = "/rest/api/3/version/%s/removeAndSwap"
+	VERSION_RELATED_ISSUE_COUNTS_ENDPOINT   = "/rest/api/3/version/%s/relatedIssueCounts"
+	VERSION_UNRESOLVED_ISSUE_COUNT_ENDPOINT = "/rest/api/3/version/%s/unresolvedIssueCount"
+
+	// Project components
+	PROJECT_COMPONENTS_PAGED_ENDPOINT       = "/rest/api/3/project/%s/component"
+	PROJECT_COMPONENTS_ENDPOINT             = "/rest/api/3/project/%s/components"
+	COMPONENT_CREATE_ENDPOINT               = "/rest/api/3/component"
+	COMPONENT_DETAIL_ENDPOINT               = "/rest/api/3/component/%s"
+	COMPONENT_RELATED_ISSUE_COUNTS_ENDPOINT = "/rest/api/3/component/%s/relatedIssueCounts"
+
+	// Project roles
+	PROJECT_ROLES_ENDPOINT        = "/rest/api/3/project/%s/role"
+	PROJECT_ROLE_ENDPOINT         = "/rest/api/3/project/%s/role/%d"
+	PROJECT_ROLE_DETAILS_ENDPOINT = "/rest/api/3/project/%s/roledetails"
+	ROLE_LIST_ENDPOINT            = "/rest/api/3/role"
+	ROLE_DETAIL_ENDPOINT          = "/rest/api/3/role/%d"
+)
+
+const (
+	// Version statuses to filter a project's versions by
+	VERSION_STATUS_RELEASED   = "released"
+	VERSION_STATUS_UNRELEASED = "unreleased"
+	VERSION_STATUS_ARCHIVED   = "archived"
+
+	// Version expand options
+	VERSION_EXPAND_OPERATIONS    = "operations"
+	VERSION_EXPAND_ISSUES_STATUS = "issuesstatus"
+	VERSION_EXPAND_DRIVER        = "driver"
+	VERSION_EXPAND_APPROVERS     = "approvers"
+
+	// Positions a version can be moved to in the version order of its project
+	VERSION_POSITION_EARLIER = "Earlier"
+	VERSION_POSITION_LATER   = "Later"
+	VERSION_POSITION_FIRST   = "First"
+	VERSION_POSITION_LAST    = "Last"
+
+	// Format of version start and release dates
+	VERSION_DATE_FORMAT = "2006-01-02"
+
+	// Nominal assignees of the issues created with a component
+	COMPONENT_ASSIGNEE_PROJECT_DEFAULT = "PROJECT_DEFAULT"
+	COMPONENT_ASSIGNEE_COMPONENT_LEAD  = "COMPONENT_LEAD"
+	COMPONENT_ASSIGNEE_PROJECT_LEAD    = "PROJECT_LEAD"
+	COMPONENT_ASSIGNEE_UNASSIGNED      = "UNASSIGNED"
+
+	// Project types
+	PROJECT_TYPE_SOFTWARE     = "software"
+	PROJECT_TYPE_SERVICE_DESK = "service_desk"
+	PROJECT_TYPE_BUSINESS     = "business"
+
+	// Project statuses to search for
+	PROJECT_STATUS_LIVE     = "live"
+	PROJECT_STATUS_ARCHIVED = "archived"
+	PROJECT_STATUS_DELETED  = "deleted"
+
+	// Permissions a user needs on the projects found by a search
+	PROJECT_ACTION_VIEW   = "view"
+	PROJECT_ACTION_BROWSE = "browse"
+	PROJECT_ACTION_EDIT   = "edit"
+
+	// Maximum number of projects per search page
+	PROJECT_SEARCH_MAX_RESULTS = 100
+
+	// Types of the actors holding a project role
+	ROLE_ACTOR_TYPE_USER  = "atlassian-user-role-actor"
+	ROLE_ACTOR_TYPE_GROUP = "atlassian-group-role-actor"
+
+	// Key of the group IDs when setting the actors of a project role
+	ROLE_ACTOR_TYPE_GROUP_ID = "atlassian-group-role-actor-id"
 )
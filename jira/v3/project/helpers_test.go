@@ -0,0 +1,59 @@
+package project
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+// newTestService returns a service calling a test server that checks the method and URL of the request,
+// passes its body to check and answers with response
+func newTestService(t *testing.T, wantMethod, wantURL string, statusCode int, response interface{}, check func(t *testing.T, body map[string]interface{})) (*Service, func()) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL := r.URL.Path
+		if r.URL.RawQuery != "" {
+			gotURL += "?" + r.URL.RawQuery
+		}
+		if gotURL != wantURL {
+			t.Errorf("URL = %v, want %v", gotURL, wantURL)
+		}
+		if r.Method != wantMethod {
+			t.Errorf("Method = %v, want %v", r.Method, wantMethod)
+		}
+
+		if check != nil {
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Errorf("Failed to read request body: %v", err)
+				return
+			}
+			body := map[string]interface{}{}
+			if err := json.Unmarshal(data, &body); err != nil {
+				t.Errorf("Failed to decode request body %s: %v", data, err)
+				return
+			}
+			check(t, body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		if statusCode >= 400 {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"errorMessages": []string{"Request failed"},
+			})
+			return
+		}
+		if response != nil {
+			_ = json.NewEncoder(w).Encode(response)
+		}
+	}))
+
+	service := NewService(&http.Client{}, server.URL, auth.NewBasicAuth("testuser", "secret123"))
+	return service, server.Close
+}
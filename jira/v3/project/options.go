@@ -1,5 +1,7 @@
 package project
 
+import "time"
+
 // ProjectUpdateOpts contains the options for updating a project
 type ProjectUpdateOpts struct {
 	// The project category ID associated with the project
@@ -65,3 +67,131 @@ type ProjectGetAllOpts struct {
 	// If access is anonymous, then the recently accessed projects are based on the current HTTP session.
 	Recent int `url:"recent,omitempty"`
 }
+
+// VersionListOpts contains the options for the ListVersions method
+type VersionListOpts struct {
+	// The index of the first version to return
+	StartAt int `url:"startAt,omitempty"`
+
+	// The maximum number of versions to return per page (default: 50)
+	MaxResults int `url:"maxResults,omitempty"`
+
+	// Order the results by a field: "description", "name", "releaseDate", "sequence" or "startDate".
+	// Prefix the field with "-" to sort in descending order
+	OrderBy string `url:"orderBy,omitempty"`
+
+	// Only return versions whose name or description contains this text (case-insensitive)
+	Query string `url:"query,omitempty"`
+
+	// Only return versions with these statuses, VERSION_STATUS_* values
+	Status []string `url:"status,omitempty"`
+
+	// Include additional information in the response, VERSION_EXPAND_* values
+	Expand []string `url:"expand,omitempty"`
+}
+
+// VersionReleaseOpts contains the options for the ReleaseVersion method
+type VersionReleaseOpts struct {
+	// The release date of the version. The zero value keeps the date already set on the version
+	ReleaseDate time.Time
+
+	// The ID of the version that the unresolved issues of the released version are moved to
+	MoveUnfixedIssuesTo string
+}
+
+// VersionMoveOpts contains the options for the MoveVersion method, exactly one of After and Position is required
+type VersionMoveOpts struct {
+	// The ID of the version to place the moved version after
+	After string
+
+	// The position to move the version to, a VERSION_POSITION_* value
+	Position string
+}
+
+// VersionDeleteOpts contains the options for the DeleteVersion method
+type VersionDeleteOpts struct {
+	// The ID of the version to set as fix version on the issues whose fix version is the deleted version
+	MoveFixIssuesTo string
+
+	// The ID of the version to set as affected version on the issues whose affected version is the deleted version
+	MoveAffectedIssuesTo string
+
+	// The versions to set on the issues whose version custom fields reference the deleted version
+	CustomFieldReplacements []VersionCustomFieldReplacement
+}
+
+// VersionCustomFieldReplacement is the replacement version of a version custom field when a version is deleted
+type VersionCustomFieldReplacement struct {
+	// The numeric ID of the custom field, e.g. "10050" for customfield_10050
+	CustomFieldID string
+
+	// The ID of the version to set on the field
+	MoveTo string
+}
+
+// ComponentListOpts contains the options for the ListComponents method
+type ComponentListOpts struct {
+	// The index of the first component to return
+	StartAt int `url:"startAt,omitempty"`
+
+	// The maximum number of components to return per page (default: 50)
+	MaxResults int `url:"maxResults,omitempty"`
+
+	// Order the results by a field: "description", "issueCount", "lead" or "name".
+	// Prefix the field with "-" to sort in descending order
+	OrderBy string `url:"orderBy,omitempty"`
+
+	// Only return components whose name or description contains this text (case-insensitive)
+	Query string `url:"query,omitempty"`
+}
+
+// ProjectSearchOpts contains the options for the SearchProjects method
+type ProjectSearchOpts struct {
+	// The index of the first project to return
+	StartAt int `url:"startAt,omitempty"`
+
+	// The maximum number of projects to return per page, up to PROJECT_SEARCH_MAX_RESULTS (default: 50)
+	MaxResults int `url:"maxResults,omitempty"`
+
+	// Order the results by a field: "category", "issueCount", "key", "lastIssueUpdatedTime", "name", "owner",
+	// "archivedDate" or "deletedDate". Prefix the field with "-" to sort in descending order
+	OrderBy string `url:"orderBy,omitempty"`
+
+	// Only return the projects with these IDs, up to 50
+	IDs []int64 `url:"id,omitempty"`
+
+	// Only return the projects with these keys, up to 50
+	Keys []string `url:"keys,omitempty"`
+
+	// Only return projects whose key or name contains this text (case-insensitive)
+	Query string `url:"query,omitempty"`
+
+	// Only return projects of these types, PROJECT_TYPE_* values
+	TypeKeys []string `url:"typeKey,omitempty"`
+
+	// Only return projects in the project category with this ID
+	CategoryID int64 `url:"categoryId,omitempty"`
+
+	// Only return projects the user has this permission on, a PROJECT_ACTION_* value (default: view)
+	Action string `url:"action,omitempty"`
+
+	// Only return projects with these statuses, PROJECT_STATUS_* values (default: live).
+	// Archived and deleted projects are only returned to Jira administrators
+	Status []string `url:"status,omitempty"`
+
+	// Use expand to include additional information in the response. This parameter accepts a comma-separated list.
+	// Expanded options include: "description", "projectKeys", "lead", "issueTypes", "url", "insight"
+	Expand string `url:"expand,omitempty"`
+
+	// A list of project properties to return for the project
+	Properties []string `url:"properties,omitempty"`
+}
+
+// RoleActorsOpts contains the users and groups to add to or set on a project role
+type RoleActorsOpts struct {
+	// The account IDs of the users
+	AccountIDs []string
+
+	// The IDs of the groups
+	GroupIDs []string
+}
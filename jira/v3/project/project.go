@@ -1,11 +1,9 @@
 package project
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -13,83 +11,24 @@ import (
 
 	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
 	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+	"github.com/ducminhgd/go-atlassian/jira/v3/rest"
 )
 
 // Service handles communication with the project related methods
 type Service struct {
-	client  *http.Client
-	baseURL string
-	auth    auth.Authenticator
+	client *rest.Client
 }
 
 // NewService creates a new service instance
 func NewService(client *http.Client, baseURL string, auth auth.Authenticator) *Service {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	return &Service{
-		client:  client,
-		baseURL: baseURL,
-		auth:    auth,
-	}
+	return NewServiceWithClient(rest.NewClient(client, baseURL, auth))
 }
 
-// newRequest creates a new HTTP request
-func (s *Service) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
-	u, err := url.Parse(s.baseURL + path)
-	if err != nil {
-		return nil, err
-	}
-
-	var buf io.ReadWriter
-	if body != nil {
-		buf = new(bytes.Buffer)
-		enc := json.NewEncoder(buf)
-		enc.SetEscapeHTML(false)
-		err := enc.Encode(body)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
-	if err != nil {
-		return nil, err
-	}
-
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
-	req.Header.Set("Accept", "application/json")
-
-	err = s.auth.AddAuthentication(req)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// do makes a request and decodes the response into v
-func (s *Service) do(req *http.Request, v interface{}) error {
-	resp, err := s.client.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("error response from API: status=%d, body=%s", resp.StatusCode, string(body))
-	}
-
-	if v != nil {
-		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-			return err
-		}
+// NewServiceWithClient creates a new service instance on top of a shared request pipeline
+func NewServiceWithClient(client *rest.Client) *Service {
+	return &Service{
+		client: client,
 	}
-
-	return nil
 }
 
 // GetAll returns all projects visible to the user
@@ -111,14 +50,14 @@ func (s *Service) GetAll(ctx context.Context, opts ProjectGetAllOpts) ([]respons
 		path = fmt.Sprintf("%s?%s", path, params.Encode())
 	}
 
-	req, err := s.newRequest(ctx, http.MethodGet, path, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	var projects []responsetypes.Project
-	if err := s.do(req, &projects); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, &projects); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 
 	return projects, nil
@@ -141,14 +80,14 @@ func (s *Service) Get(ctx context.Context, projectIDOrKey string, opts ProjectQu
 		path = fmt.Sprintf("%s?%s", path, params.Encode())
 	}
 
-	req, err := s.newRequest(ctx, http.MethodGet, path, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	project := new(responsetypes.Project)
-	if err := s.do(req, project); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, project); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 
 	return project, nil
@@ -156,14 +95,14 @@ func (s *Service) Get(ctx context.Context, projectIDOrKey string, opts ProjectQu
 
 // Create creates a new project
 func (s *Service) Create(ctx context.Context, project *responsetypes.Project) (*responsetypes.Project, error) {
-	req, err := s.newRequest(ctx, http.MethodPost, PROJECT_LIST_ENDPOINT, project)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, PROJECT_LIST_ENDPOINT, project)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	createdProject := new(responsetypes.Project)
-	if err := s.do(req, createdProject); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, createdProject); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 
 	return createdProject, nil
@@ -182,14 +121,14 @@ func (s *Service) Update(ctx context.Context, project *responsetypes.Project, op
 		path = fmt.Sprintf("%s?expand=%s", path, url.QueryEscape(opts.Expand))
 	}
 
-	req, err := s.newRequest(ctx, http.MethodPut, path, project)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, project)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	updatedProject := new(responsetypes.Project)
-	if err := s.do(req, updatedProject); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, updatedProject); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 
 	return updatedProject, nil
@@ -205,13 +144,13 @@ func (s *Service) Delete(ctx context.Context, projectIDOrKey string, async bool)
 		path = fmt.Sprintf(PROJECT_DETAIL_ENDPOINT, projectIDOrKey)
 		method = http.MethodDelete
 	}
-	req, err := s.newRequest(ctx, method, path, nil)
+	req, err := s.client.NewRequest(ctx, method, path, nil)
 	if err != nil {
-		return fmt.Errorf("error creating request: %v", err)
+		return fmt.Errorf("error creating request: %w", err)
 	}
 
-	if err := s.do(req, nil); err != nil {
-		return fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
 	}
 
 	return nil
@@ -220,19 +159,20 @@ func (s *Service) Delete(ctx context.Context, projectIDOrKey string, async bool)
 // Archive archives a project
 func (s *Service) Archive(ctx context.Context, projectIDOrKey string) error {
 	path := fmt.Sprintf(PROJECT_ARCHIVE_ENDPOINT, projectIDOrKey)
-	req, err := s.newRequest(ctx, http.MethodPost, path, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
-		return fmt.Errorf("error creating request: %v", err)
+		return fmt.Errorf("error creating request: %w", err)
 	}
 
-	if err := s.do(req, nil); err != nil {
-		return fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
 	}
 
 	return nil
 }
 
-// Search returns a paginated list of projects
+// Search returns a paginated list of projects whose key or name contains query.
+// Use SearchProjects to filter by status, type, category and more.
 func (s *Service) Search(ctx context.Context, startAt, maxResults int, query string) (*responsetypes.ProjectListResponse, error) {
 	params := url.Values{}
 	params.Add("startAt", strconv.Itoa(startAt))
@@ -242,29 +182,146 @@ func (s *Service) Search(ctx context.Context, startAt, maxResults int, query str
 	}
 
 	path := fmt.Sprintf(PROJECT_SEARCH_ENDPOINT, params.Encode())
-	req, err := s.newRequest(ctx, http.MethodGet, path, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	response := new(responsetypes.ProjectListResponse)
+	if err := s.client.Do(req, response); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return response, nil
+}
+
+// SearchProjects returns a page of the projects matching opts
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-search-get
+func (s *Service) SearchProjects(ctx context.Context, opts ProjectSearchOpts) (*responsetypes.ProjectListResponse, error) {
+	params := url.Values{}
+
+	if opts.StartAt > 0 {
+		params.Add("startAt", strconv.Itoa(opts.StartAt))
+	}
+	if opts.MaxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(opts.MaxResults))
+	}
+	if opts.OrderBy != "" {
+		params.Add("orderBy", opts.OrderBy)
+	}
+	for _, id := range opts.IDs {
+		params.Add("id", strconv.FormatInt(id, 10))
+	}
+	for _, key := range opts.Keys {
+		params.Add("keys", key)
+	}
+	if opts.Query != "" {
+		params.Add("query", opts.Query)
+	}
+	if len(opts.TypeKeys) > 0 {
+		params.Add("typeKey", strings.Join(opts.TypeKeys, ","))
+	}
+	if opts.CategoryID > 0 {
+		params.Add("categoryId", strconv.FormatInt(opts.CategoryID, 10))
+	}
+	if opts.Action != "" {
+		params.Add("action", opts.Action)
+	}
+	for _, status := range opts.Status {
+		params.Add("status", status)
+	}
+	if opts.Expand != "" {
+		params.Add("expand", opts.Expand)
+	}
+	if len(opts.Properties) > 0 {
+		params.Add("properties", strings.Join(opts.Properties, ","))
+	}
+
+	path := strings.TrimSuffix(fmt.Sprintf(PROJECT_SEARCH_ENDPOINT, params.Encode()), "?")
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	response := new(responsetypes.ProjectListResponse)
-	if err := s.do(req, response); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, response); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 
 	return response, nil
 }
 
+// SearchProjectsAll returns the projects of every page matching opts.
+// When maxProjects is greater than zero, at most maxProjects projects are returned.
+func (s *Service) SearchProjectsAll(ctx context.Context, opts ProjectSearchOpts, maxProjects int) ([]responsetypes.Project, error) {
+	var projects []responsetypes.Project
+	for project, err := range s.SearchProjectsIter(ctx, opts, maxProjects) {
+		if err != nil {
+			return projects, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+// SearchProjectsIter returns an iterator over every project matching opts, starting at opts.StartAt.
+// Pages are fetched lazily until isLast. The iteration stops after yielding an error,
+// including the context error when ctx is cancelled.
+// When maxProjects is greater than zero, at most maxProjects projects are yielded.
+func (s *Service) SearchProjectsIter(ctx context.Context, opts ProjectSearchOpts, maxProjects int) iter.Seq2[responsetypes.Project, error] {
+	return func(yield func(responsetypes.Project, error) bool) {
+		pageSize := opts.MaxResults
+		if pageSize <= 0 || pageSize > PROJECT_SEARCH_MAX_RESULTS {
+			pageSize = PROJECT_SEARCH_MAX_RESULTS
+		}
+
+		count := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(responsetypes.Project{}, err)
+				return
+			}
+
+			opts.MaxResults = pageSize
+			if maxProjects > 0 {
+				opts.MaxResults = min(pageSize, maxProjects-count)
+			}
+
+			page, err := s.SearchProjects(ctx, opts)
+			if err != nil {
+				yield(responsetypes.Project{}, err)
+				return
+			}
+
+			for _, project := range page.Values {
+				if !yield(project, nil) {
+					return
+				}
+				count++
+				if maxProjects > 0 && count >= maxProjects {
+					return
+				}
+			}
+
+			// Stop on the last page, and guard against a server that returns an empty page
+			if page.IsLast || len(page.Values) == 0 {
+				return
+			}
+			opts.StartAt += len(page.Values)
+		}
+	}
+}
+
 // GetRecent returns a list of up to 20 projects recently viewed by the user
 func (s *Service) GetRecent(ctx context.Context) ([]responsetypes.Project, error) {
-	req, err := s.newRequest(ctx, http.MethodGet, PROJECT_RECENT_ENDPOINT, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, PROJECT_RECENT_ENDPOINT, nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	var projects []responsetypes.Project
-	if err := s.do(req, &projects); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, &projects); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 
 	return projects, nil
@@ -273,14 +330,14 @@ func (s *Service) GetRecent(ctx context.Context) ([]responsetypes.Project, error
 // GetAllStatuses returns all statuses associated with a project
 func (s *Service) GetAllStatuses(ctx context.Context, projectIDOrKey string) ([]responsetypes.IssueType, error) {
 	path := fmt.Sprintf(PROJECT_STATUS_ENDPOINT, projectIDOrKey)
-	req, err := s.newRequest(ctx, http.MethodGet, path, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	var issueTypes []responsetypes.IssueType
-	if err := s.do(req, &issueTypes); err != nil {
-		return nil, fmt.Errorf("error making request: %v", err)
+	if err := s.client.Do(req, &issueTypes); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
 	}
 
 	return issueTypes, nil
@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -757,3 +758,175 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchProjects(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ProjectSearchOpts
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "no options",
+			wantURL:    "/rest/api/3/project/search",
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "with filters",
+			opts: ProjectSearchOpts{
+				StartAt:    100,
+				MaxResults: 50,
+				OrderBy:    "-lastIssueUpdatedTime",
+				Keys:       []string{"ABC", "XYZ"},
+				TypeKeys:   []string{PROJECT_TYPE_SOFTWARE, PROJECT_TYPE_BUSINESS},
+				CategoryID: 10000,
+				Action:     PROJECT_ACTION_BROWSE,
+				Status:     []string{PROJECT_STATUS_ARCHIVED, PROJECT_STATUS_DELETED},
+				Expand:     "lead,insight",
+				Properties: []string{"prop1", "prop2"},
+			},
+			wantURL:    "/rest/api/3/project/search?action=browse&categoryId=10000&expand=lead%2Cinsight&keys=ABC&keys=XYZ&maxResults=50&orderBy=-lastIssueUpdatedTime&properties=prop1%2Cprop2&startAt=100&status=archived&status=deleted&typeKey=software%2Cbusiness",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with IDs and query",
+			opts:       ProjectSearchOpts{IDs: []int64{10000, 10001}, Query: "pay"},
+			wantURL:    "/rest/api/3/project/search?id=10000&id=10001&query=pay",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "forbidden",
+			opts:       ProjectSearchOpts{Status: []string{PROJECT_STATUS_DELETED}},
+			wantURL:    "/rest/api/3/project/search?status=deleted",
+			wantErr:    true,
+			statusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := responsetypes.ProjectListResponse{
+				Total:  1,
+				IsLast: true,
+				Values: []responsetypes.Project{{ID: "10000", Key: "ABC"}},
+			}
+			service, done := newTestService(t, http.MethodGet, tt.wantURL, tt.statusCode, response, nil)
+			defer done()
+
+			page, err := service.SearchProjects(context.Background(), tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SearchProjects() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(page.Values) != 1 || page.Values[0].Key != "ABC") {
+				t.Errorf("SearchProjects() values = %+v, want project ABC", page.Values)
+			}
+		})
+	}
+}
+
+func TestSearchProjectsIter(t *testing.T) {
+	projects := []responsetypes.Project{{Key: "A"}, {Key: "B"}, {Key: "C"}, {Key: "D"}, {Key: "E"}}
+
+	tests := []struct {
+		name         string
+		maxResults   int
+		maxProjects  int
+		failAt       int
+		wantKeys     []string
+		wantRequests []string
+		wantErr      bool
+	}{
+		{
+			name:         "every page",
+			maxResults:   2,
+			wantKeys:     []string{"A", "B", "C", "D", "E"},
+			wantRequests: []string{"maxResults=2&status=archived", "maxResults=2&startAt=2&status=archived", "maxResults=2&startAt=4&status=archived"},
+		},
+		{
+			name:         "limited",
+			maxResults:   2,
+			maxProjects:  3,
+			wantKeys:     []string{"A", "B", "C"},
+			wantRequests: []string{"maxResults=2&status=archived", "maxResults=1&startAt=2&status=archived"},
+		},
+		{
+			name:         "default page size",
+			wantKeys:     []string{"A", "B", "C", "D", "E"},
+			wantRequests: []string{"maxResults=100&status=archived"},
+		},
+		{
+			name:         "error on second page",
+			maxResults:   2,
+			failAt:       2,
+			wantKeys:     []string{"A", "B"},
+			wantRequests: []string{"maxResults=2&status=archived", "maxResults=2&startAt=2&status=archived"},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.URL.RawQuery)
+				if len(requests) == tt.failAt {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				query := r.URL.Query()
+				start, size := 0, 0
+				_, _ = fmt.Sscan(query.Get("startAt"), &start)
+				_, _ = fmt.Sscan(query.Get("maxResults"), &size)
+				end := min(start+size, len(projects))
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(responsetypes.ProjectListResponse{
+					StartAt: start,
+					Total:   len(projects),
+					IsLast:  end == len(projects),
+					Values:  projects[start:end],
+				})
+			}))
+			defer server.Close()
+
+			service := NewService(&http.Client{}, server.URL, auth.NewBasicAuth("testuser", "secret123"))
+			opts := ProjectSearchOpts{MaxResults: tt.maxResults, Status: []string{PROJECT_STATUS_ARCHIVED}}
+
+			var keys []string
+			var err error
+			for project, iterErr := range service.SearchProjectsIter(context.Background(), opts, tt.maxProjects) {
+				if iterErr != nil {
+					err = iterErr
+					break
+				}
+				keys = append(keys, project.Key)
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SearchProjectsIter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("SearchProjectsIter() keys = %v, want %v", keys, tt.wantKeys)
+			}
+			if !reflect.DeepEqual(requests, tt.wantRequests) {
+				t.Errorf("SearchProjectsIter() requests = %v, want %v", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestSearchProjectsAll_Cancelled(t *testing.T) {
+	service := NewService(&http.Client{}, "https://example.invalid", auth.NewBasicAuth("testuser", "secret123"))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	projects, err := service.SearchProjectsAll(ctx, ProjectSearchOpts{}, 0)
+	if err != context.Canceled {
+		t.Errorf("SearchProjectsAll() error = %v, want %v", err, context.Canceled)
+	}
+	if len(projects) != 0 {
+		t.Errorf("SearchProjectsAll() = %v, want no projects", projects)
+	}
+}
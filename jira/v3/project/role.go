@@ -0,0 +1,279 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// ListRoles returns the roles of a project as a map of role names to the URLs of the roles in the project
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-project-projectidorkey-role-get
+func (s *Service) ListRoles(ctx context.Context, projectIDOrKey string) (map[string]string, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(PROJECT_ROLES_ENDPOINT, projectIDOrKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	roles := map[string]string{}
+	if err := s.client.Do(req, &roles); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return roles, nil
+}
+
+// ListRoleDetails returns the roles of a project with their IDs and descriptions, without their actors
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-project-projectidorkey-roledetails-get
+func (s *Service) ListRoleDetails(ctx context.Context, projectIDOrKey string) ([]responsetypes.ProjectRole, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+
+	return s.requestRoles(ctx, fmt.Sprintf(PROJECT_ROLE_DETAILS_ENDPOINT, projectIDOrKey))
+}
+
+// GetRole returns a role of a project with the users and groups holding it.
+// When excludeInactiveUsers is true, deactivated users are left out of the actors.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-project-projectidorkey-role-id-get
+func (s *Service) GetRole(ctx context.Context, projectIDOrKey string, roleID int64, excludeInactiveUsers bool) (*responsetypes.ProjectRole, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+	if roleID <= 0 {
+		return nil, fmt.Errorf("role ID is required")
+	}
+
+	path := fmt.Sprintf(PROJECT_ROLE_ENDPOINT, projectIDOrKey, roleID)
+	if excludeInactiveUsers {
+		path += "?excludeInactiveUsers=true"
+	}
+
+	return s.requestRole(ctx, http.MethodGet, path, nil)
+}
+
+// GetRoleByName returns the role of a project with the given name (case-insensitive), with the users and groups holding it
+func (s *Service) GetRoleByName(ctx context.Context, projectIDOrKey, name string, excludeInactiveUsers bool) (*responsetypes.ProjectRole, error) {
+	if name == "" {
+		return nil, fmt.Errorf("role name is required")
+	}
+
+	roles, err := s.ListRoles(ctx, projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	roleURL, ok := roles[name]
+	if !ok {
+		for roleName, u := range roles {
+			if strings.EqualFold(roleName, name) {
+				roleURL, ok = u, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("project %s has no role %q", projectIDOrKey, name)
+	}
+
+	// The role ID is the last segment of the role URL
+	roleID, err := strconv.ParseInt(roleURL[strings.LastIndex(roleURL, "/")+1:], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid role URL %q: %w", roleURL, err)
+	}
+
+	return s.GetRole(ctx, projectIDOrKey, roleID, excludeInactiveUsers)
+}
+
+// AddRoleActors grants a role of a project to users and groups
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-project-projectidorkey-role-id-post
+func (s *Service) AddRoleActors(ctx context.Context, projectIDOrKey string, roleID int64, actors RoleActorsOpts) (*responsetypes.ProjectRole, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+	if roleID <= 0 {
+		return nil, fmt.Errorf("role ID is required")
+	}
+	if len(actors.AccountIDs) == 0 && len(actors.GroupIDs) == 0 {
+		return nil, fmt.Errorf("at least one account ID or group ID is required")
+	}
+
+	payload := struct {
+		User    []string `json:"user,omitempty"`
+		GroupID []string `json:"groupId,omitempty"`
+	}{
+		User:    actors.AccountIDs,
+		GroupID: actors.GroupIDs,
+	}
+
+	return s.requestRole(ctx, http.MethodPost, fmt.Sprintf(PROJECT_ROLE_ENDPOINT, projectIDOrKey, roleID), payload)
+}
+
+// SetRoleActors replaces the users and groups holding a role of a project. Actors left out of actors lose the role.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-project-projectidorkey-role-id-put
+func (s *Service) SetRoleActors(ctx context.Context, projectIDOrKey string, roleID int64, actors RoleActorsOpts) (*responsetypes.ProjectRole, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+	if roleID <= 0 {
+		return nil, fmt.Errorf("role ID is required")
+	}
+
+	// Both lists are always sent, an empty list removes every actor of that type
+	categorisedActors := map[string][]string{
+		ROLE_ACTOR_TYPE_USER:     append([]string{}, actors.AccountIDs...),
+		ROLE_ACTOR_TYPE_GROUP_ID: append([]string{}, actors.GroupIDs...),
+	}
+	payload := map[string]interface{}{
+		"id":                roleID,
+		"categorisedActors": categorisedActors,
+	}
+
+	return s.requestRole(ctx, http.MethodPut, fmt.Sprintf(PROJECT_ROLE_ENDPOINT, projectIDOrKey, roleID), payload)
+}
+
+// RemoveRoleUser revokes a role of a project from a user
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-project-projectidorkey-role-id-delete
+func (s *Service) RemoveRoleUser(ctx context.Context, projectIDOrKey string, roleID int64, accountID string) error {
+	if accountID == "" {
+		return fmt.Errorf("account ID is required")
+	}
+
+	return s.removeRoleActor(ctx, projectIDOrKey, roleID, url.Values{"user": {accountID}})
+}
+
+// RemoveRoleGroup revokes a role of a project from a group
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-role-actors/#api-rest-api-3-project-projectidorkey-role-id-delete
+func (s *Service) RemoveRoleGroup(ctx context.Context, projectIDOrKey string, roleID int64, groupID string) error {
+	if groupID == "" {
+		return fmt.Errorf("group ID is required")
+	}
+
+	return s.removeRoleActor(ctx, projectIDOrKey, roleID, url.Values{"groupId": {groupID}})
+}
+
+// ListRoleDefinitions returns the project roles defined in Jira, with their default actors
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-get
+func (s *Service) ListRoleDefinitions(ctx context.Context) ([]responsetypes.ProjectRole, error) {
+	return s.requestRoles(ctx, ROLE_LIST_ENDPOINT)
+}
+
+// GetRoleDefinition returns a project role defined in Jira, with its default actors
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-id-get
+func (s *Service) GetRoleDefinition(ctx context.Context, roleID int64) (*responsetypes.ProjectRole, error) {
+	if roleID <= 0 {
+		return nil, fmt.Errorf("role ID is required")
+	}
+
+	return s.requestRole(ctx, http.MethodGet, fmt.Sprintf(ROLE_DETAIL_ENDPOINT, roleID), nil)
+}
+
+// CreateRoleDefinition creates a project role, available to every project
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-post
+func (s *Service) CreateRoleDefinition(ctx context.Context, name, description string) (*responsetypes.ProjectRole, error) {
+	if name == "" {
+		return nil, fmt.Errorf("role name is required")
+	}
+
+	payload := &responsetypes.ProjectRole{Name: name, Description: description}
+	return s.requestRole(ctx, http.MethodPost, ROLE_LIST_ENDPOINT, payload)
+}
+
+// UpdateRoleDefinition changes the name or the description of a project role, empty values are left unchanged
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-id-post
+func (s *Service) UpdateRoleDefinition(ctx context.Context, roleID int64, name, description string) (*responsetypes.ProjectRole, error) {
+	if roleID <= 0 {
+		return nil, fmt.Errorf("role ID is required")
+	}
+	if name == "" && description == "" {
+		return nil, fmt.Errorf("role name or description is required")
+	}
+
+	payload := &responsetypes.ProjectRole{Name: name, Description: description}
+	return s.requestRole(ctx, http.MethodPost, fmt.Sprintf(ROLE_DETAIL_ENDPOINT, roleID), payload)
+}
+
+// DeleteRoleDefinition deletes a project role. When swapRoleID is greater than zero, the role is replaced by
+// that role wherever it is used in schemes; Jira rejects the deletion of a role in use without a swap role.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-roles/#api-rest-api-3-role-id-delete
+func (s *Service) DeleteRoleDefinition(ctx context.Context, roleID, swapRoleID int64) error {
+	if roleID <= 0 {
+		return fmt.Errorf("role ID is required")
+	}
+
+	path := fmt.Sprintf(ROLE_DETAIL_ENDPOINT, roleID)
+	if swapRoleID > 0 {
+		path = fmt.Sprintf("%s?swap=%d", path, swapRoleID)
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// removeRoleActor revokes a role of a project from the actor identified by params
+func (s *Service) removeRoleActor(ctx context.Context, projectIDOrKey string, roleID int64, params url.Values) error {
+	if projectIDOrKey == "" {
+		return fmt.Errorf("project ID or key is required")
+	}
+	if roleID <= 0 {
+		return fmt.Errorf("role ID is required")
+	}
+
+	path := fmt.Sprintf("%s?%s", fmt.Sprintf(PROJECT_ROLE_ENDPOINT, projectIDOrKey, roleID), params.Encode())
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// requestRole sends a request with an optional payload and decodes the role in the response
+func (s *Service) requestRole(ctx context.Context, method, path string, payload interface{}) (*responsetypes.ProjectRole, error) {
+	req, err := s.client.NewRequest(ctx, method, path, payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	role := new(responsetypes.ProjectRole)
+	if err := s.client.Do(req, role); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return role, nil
+}
+
+// requestRoles decodes the list of roles returned by path
+func (s *Service) requestRoles(ctx context.Context, path string) ([]responsetypes.ProjectRole, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	var roles []responsetypes.ProjectRole
+	if err := s.client.Do(req, &roles); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,365 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+func TestListRoles(t *testing.T) {
+	roles := map[string]string{
+		"Administrators": "https://your-domain.atlassian.net/rest/api/3/project/TEST/role/10002",
+		"Developers":     "https://your-domain.atlassian.net/rest/api/3/project/TEST/role/10001",
+	}
+	service, done := newTestService(t, http.MethodGet, "/rest/api/3/project/TEST/role", http.StatusOK, roles, nil)
+	defer done()
+
+	got, err := service.ListRoles(context.Background(), "TEST")
+	if err != nil {
+		t.Fatalf("ListRoles() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, roles) {
+		t.Errorf("ListRoles() = %v, want %v", got, roles)
+	}
+
+	if _, err := service.ListRoles(context.Background(), ""); err == nil {
+		t.Error("ListRoles() without project error = nil, want error")
+	}
+}
+
+func TestListRoleDetails(t *testing.T) {
+	roles := []responsetypes.ProjectRole{{ID: 10002, Name: "Administrators", Admin: true}, {ID: 10001, Name: "Developers"}}
+	service, done := newTestService(t, http.MethodGet, "/rest/api/3/project/TEST/roledetails", http.StatusOK, roles, nil)
+	defer done()
+
+	got, err := service.ListRoleDetails(context.Background(), "TEST")
+	if err != nil {
+		t.Fatalf("ListRoleDetails() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, roles) {
+		t.Errorf("ListRoleDetails() = %+v, want %+v", got, roles)
+	}
+}
+
+func TestGetRole(t *testing.T) {
+	tests := []struct {
+		name                 string
+		projectIDOrKey       string
+		roleID               int64
+		excludeInactiveUsers bool
+		wantURL              string
+		wantErr              bool
+		statusCode           int
+	}{
+		{
+			name:           "success",
+			projectIDOrKey: "TEST",
+			roleID:         10002,
+			wantURL:        "/rest/api/3/project/TEST/role/10002",
+			statusCode:     http.StatusOK,
+		},
+		{
+			name:                 "exclude inactive users",
+			projectIDOrKey:       "TEST",
+			roleID:               10002,
+			excludeInactiveUsers: true,
+			wantURL:              "/rest/api/3/project/TEST/role/10002?excludeInactiveUsers=true",
+			statusCode:           http.StatusOK,
+		},
+		{
+			name:           "not found",
+			projectIDOrKey: "TEST",
+			roleID:         99999,
+			wantURL:        "/rest/api/3/project/TEST/role/99999",
+			wantErr:        true,
+			statusCode:     http.StatusNotFound,
+		},
+		{
+			name:    "missing project",
+			roleID:  10002,
+			wantErr: true,
+		},
+		{
+			name:           "missing role ID",
+			projectIDOrKey: "TEST",
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := responsetypes.ProjectRole{
+				ID:   10002,
+				Name: "Administrators",
+				Actors: []responsetypes.RoleActor{
+					{ID: 1, Type: ROLE_ACTOR_TYPE_USER, DisplayName: "Jane", ActorUser: &responsetypes.RoleActorUser{AccountID: "user-1"}},
+					{ID: 2, Type: ROLE_ACTOR_TYPE_GROUP, DisplayName: "jira-admins", ActorGroup: &responsetypes.RoleActorGroup{Name: "jira-admins", GroupID: "group-1"}},
+				},
+			}
+			service, done := newTestService(t, http.MethodGet, tt.wantURL, tt.statusCode, response, nil)
+			defer done()
+
+			role, err := service.GetRole(context.Background(), tt.projectIDOrKey, tt.roleID, tt.excludeInactiveUsers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(*role, response) {
+				t.Errorf("GetRole() = %+v, want %+v", role, response)
+			}
+		})
+	}
+}
+
+func TestGetRoleByName(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/project/TEST/role":
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"Administrators": "http://" + r.Host + "/rest/api/3/project/TEST/role/10002",
+				"Developers":     "http://" + r.Host + "/rest/api/3/project/TEST/role/10001",
+			})
+		case "/rest/api/3/project/TEST/role/10002":
+			_ = json.NewEncoder(w).Encode(responsetypes.ProjectRole{ID: 10002, Name: "Administrators"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(&http.Client{}, server.URL, auth.NewBasicAuth("testuser", "secret123"))
+
+	role, err := service.GetRoleByName(context.Background(), "TEST", "administrators", false)
+	if err != nil {
+		t.Fatalf("GetRoleByName() error = %v", err)
+	}
+	if role.ID != 10002 {
+		t.Errorf("GetRoleByName() ID = %d, want 10002", role.ID)
+	}
+	wantRequests := []string{"/rest/api/3/project/TEST/role", "/rest/api/3/project/TEST/role/10002"}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("GetRoleByName() requests = %v, want %v", requests, wantRequests)
+	}
+
+	if _, err := service.GetRoleByName(context.Background(), "TEST", "Viewers", false); err == nil {
+		t.Error("GetRoleByName() unknown role error = nil, want error")
+	}
+	if _, err := service.GetRoleByName(context.Background(), "TEST", "", false); err == nil {
+		t.Error("GetRoleByName() without name error = nil, want error")
+	}
+}
+
+func TestAddRoleActors(t *testing.T) {
+	tests := []struct {
+		name     string
+		actors   RoleActorsOpts
+		wantBody map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "users",
+			actors:   RoleActorsOpts{AccountIDs: []string{"user-1", "user-2"}},
+			wantBody: map[string]interface{}{"user": []interface{}{"user-1", "user-2"}},
+		},
+		{
+			name:     "groups",
+			actors:   RoleActorsOpts{GroupIDs: []string{"group-1"}},
+			wantBody: map[string]interface{}{"groupId": []interface{}{"group-1"}},
+		},
+		{
+			name:    "no actors",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(t *testing.T, body map[string]interface{}) {
+				if !reflect.DeepEqual(body, tt.wantBody) {
+					t.Errorf("body = %v, want %v", body, tt.wantBody)
+				}
+			}
+			service, done := newTestService(t, http.MethodPost, "/rest/api/3/project/TEST/role/10002", http.StatusOK, responsetypes.ProjectRole{ID: 10002}, check)
+			defer done()
+
+			_, err := service.AddRoleActors(context.Background(), "TEST", 10002, tt.actors)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddRoleActors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetRoleActors(t *testing.T) {
+	tests := []struct {
+		name     string
+		actors   RoleActorsOpts
+		wantBody map[string]interface{}
+	}{
+		{
+			name:   "users and groups",
+			actors: RoleActorsOpts{AccountIDs: []string{"user-1"}, GroupIDs: []string{"group-1"}},
+			wantBody: map[string]interface{}{
+				"id": float64(10002),
+				"categorisedActors": map[string]interface{}{
+					"atlassian-user-role-actor":     []interface{}{"user-1"},
+					"atlassian-group-role-actor-id": []interface{}{"group-1"},
+				},
+			},
+		},
+		{
+			name: "no actors",
+			wantBody: map[string]interface{}{
+				"id": float64(10002),
+				"categorisedActors": map[string]interface{}{
+					"atlassian-user-role-actor":     []interface{}{},
+					"atlassian-group-role-actor-id": []interface{}{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(t *testing.T, body map[string]interface{}) {
+				if !reflect.DeepEqual(body, tt.wantBody) {
+					t.Errorf("body = %v, want %v", body, tt.wantBody)
+				}
+			}
+			service, done := newTestService(t, http.MethodPut, "/rest/api/3/project/TEST/role/10002", http.StatusOK, responsetypes.ProjectRole{ID: 10002}, check)
+			defer done()
+
+			if _, err := service.SetRoleActors(context.Background(), "TEST", 10002, tt.actors); err != nil {
+				t.Fatalf("SetRoleActors() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestRemoveRoleActor(t *testing.T) {
+	tests := []struct {
+		name       string
+		remove     func(s *Service) error
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name: "user",
+			remove: func(s *Service) error {
+				return s.RemoveRoleUser(context.Background(), "TEST", 10002, "user-1")
+			},
+			wantURL:    "/rest/api/3/project/TEST/role/10002?user=user-1",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "group",
+			remove: func(s *Service) error {
+				return s.RemoveRoleGroup(context.Background(), "TEST", 10002, "group-1")
+			},
+			wantURL:    "/rest/api/3/project/TEST/role/10002?groupId=group-1",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "not an actor",
+			remove: func(s *Service) error {
+				return s.RemoveRoleUser(context.Background(), "TEST", 10002, "user-9")
+			},
+			wantURL:    "/rest/api/3/project/TEST/role/10002?user=user-9",
+			wantErr:    true,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "missing account ID",
+			remove: func(s *Service) error {
+				return s.RemoveRoleUser(context.Background(), "TEST", 10002, "")
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing role ID",
+			remove: func(s *Service) error {
+				return s.RemoveRoleGroup(context.Background(), "TEST", 0, "group-1")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, done := newTestService(t, http.MethodDelete, tt.wantURL, tt.statusCode, nil, nil)
+			defer done()
+
+			err := tt.remove(service)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("remove error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRoleDefinitions(t *testing.T) {
+	roles := []responsetypes.ProjectRole{{ID: 10002, Name: "Administrators"}}
+	service, done := newTestService(t, http.MethodGet, "/rest/api/3/role", http.StatusOK, roles, nil)
+	got, err := service.ListRoleDefinitions(context.Background())
+	done()
+	if err != nil {
+		t.Fatalf("ListRoleDefinitions() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, roles) {
+		t.Errorf("ListRoleDefinitions() = %+v, want %+v", got, roles)
+	}
+
+	service, done = newTestService(t, http.MethodGet, "/rest/api/3/role/10002", http.StatusOK, roles[0], nil)
+	role, err := service.GetRoleDefinition(context.Background(), 10002)
+	done()
+	if err != nil || role.Name != "Administrators" {
+		t.Errorf("GetRoleDefinition() = %+v, %v, want Administrators", role, err)
+	}
+
+	wantBody := map[string]interface{}{"name": "Auditors", "description": "Read-only access"}
+	check := func(t *testing.T, body map[string]interface{}) {
+		if !reflect.DeepEqual(body, wantBody) {
+			t.Errorf("body = %v, want %v", body, wantBody)
+		}
+	}
+	service, done = newTestService(t, http.MethodPost, "/rest/api/3/role", http.StatusOK, responsetypes.ProjectRole{ID: 10100, Name: "Auditors"}, check)
+	role, err = service.CreateRoleDefinition(context.Background(), "Auditors", "Read-only access")
+	done()
+	if err != nil || role.ID != 10100 {
+		t.Errorf("CreateRoleDefinition() = %+v, %v, want role 10100", role, err)
+	}
+
+	wantBody = map[string]interface{}{"description": "Read-only access"}
+	service, done = newTestService(t, http.MethodPost, "/rest/api/3/role/10100", http.StatusOK, responsetypes.ProjectRole{ID: 10100}, check)
+	_, err = service.UpdateRoleDefinition(context.Background(), 10100, "", "Read-only access")
+	done()
+	if err != nil {
+		t.Errorf("UpdateRoleDefinition() error = %v", err)
+	}
+
+	service, done = newTestService(t, http.MethodDelete, "/rest/api/3/role/10100?swap=10001", http.StatusNoContent, nil, nil)
+	err = service.DeleteRoleDefinition(context.Background(), 10100, 10001)
+	done()
+	if err != nil {
+		t.Errorf("DeleteRoleDefinition() error = %v", err)
+	}
+
+	if _, err := service.CreateRoleDefinition(context.Background(), "", ""); err == nil {
+		t.Error("CreateRoleDefinition() without name error = nil, want error")
+	}
+	if _, err := service.UpdateRoleDefinition(context.Background(), 10100, "", ""); err == nil {
+		t.Error("UpdateRoleDefinition() without changes error = nil, want error")
+	}
+	if err := service.DeleteRoleDefinition(context.Background(), 0, 0); err == nil {
+		t.Error("DeleteRoleDefinition() without ID error = nil, want error")
+	}
+}
@@ -0,0 +1,305 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+// ListVersions returns a page of the versions of a project
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-project-projectidorkey-version-get
+func (s *Service) ListVersions(ctx context.Context, projectIDOrKey string, opts VersionListOpts) (*responsetypes.ProjectVersionListResponse, error) {
+	if projectIDOrKey == "" {
+		return nil, fmt.Errorf("project ID or key is required")
+	}
+
+	path := fmt.Sprintf(PROJECT_VERSIONS_ENDPOINT, projectIDOrKey)
+	params := url.Values{}
+
+	if opts.StartAt > 0 {
+		params.Add("startAt", strconv.Itoa(opts.StartAt))
+	}
+	if opts.MaxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(opts.MaxResults))
+	}
+	if opts.OrderBy != "" {
+		params.Add("orderBy", opts.OrderBy)
+	}
+	if opts.Query != "" {
+		params.Add("query", opts.Query)
+	}
+	if len(opts.Status) > 0 {
+		params.Add("status", strings.Join(opts.Status, ","))
+	}
+	if len(opts.Expand) > 0 {
+		params.Add("expand", strings.Join(opts.Expand, ","))
+	}
+
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	response := new(responsetypes.ProjectVersionListResponse)
+	if err := s.client.Do(req, response); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return response, nil
+}
+
+// ListAllVersions returns every version of a project, starting at opts.StartAt and
+// requesting pages of opts.MaxResults versions until the last page
+func (s *Service) ListAllVersions(ctx context.Context, projectIDOrKey string, opts VersionListOpts) ([]responsetypes.ProjectVersion, error) {
+	var versions []responsetypes.ProjectVersion
+	for {
+		page, err := s.ListVersions(ctx, projectIDOrKey, opts)
+		if err != nil {
+			return versions, err
+		}
+
+		versions = append(versions, page.Values...)
+		opts.StartAt += len(page.Values)
+		if len(page.Values) == 0 || page.IsLast || opts.StartAt >= page.Total {
+			return versions, nil
+		}
+	}
+}
+
+// GetVersion returns a version, expand takes VERSION_EXPAND_* values
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-get
+func (s *Service) GetVersion(ctx context.Context, versionID string, expand []string) (*responsetypes.ProjectVersion, error) {
+	if versionID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+
+	path := fmt.Sprintf(VERSION_DETAIL_ENDPOINT, versionID)
+	if len(expand) > 0 {
+		path = fmt.Sprintf("%s?expand=%s", path, url.QueryEscape(strings.Join(expand, ",")))
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	version := new(responsetypes.ProjectVersion)
+	if err := s.client.Do(req, version); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return version, nil
+}
+
+// CreateVersion creates a version in the project set by version.ProjectID
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-post
+func (s *Service) CreateVersion(ctx context.Context, version *responsetypes.ProjectVersion) (*responsetypes.ProjectVersion, error) {
+	if version == nil || version.Name == "" {
+		return nil, fmt.Errorf("version name is required")
+	}
+	if version.ProjectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+
+	return s.writeVersion(ctx, http.MethodPost, VERSION_CREATE_ENDPOINT, version)
+}
+
+// UpdateVersion updates the version identified by version.ID. Zero fields are left unchanged,
+// use UnreleaseVersion to clear the released flag.
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-put
+func (s *Service) UpdateVersion(ctx context.Context, version *responsetypes.ProjectVersion) (*responsetypes.ProjectVersion, error) {
+	if version == nil || version.ID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+
+	return s.writeVersion(ctx, http.MethodPut, fmt.Sprintf(VERSION_DETAIL_ENDPOINT, version.ID), version)
+}
+
+// ReleaseVersion marks a version as released, optionally moving its unresolved issues to another version
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-put
+func (s *Service) ReleaseVersion(ctx context.Context, versionID string, opts VersionReleaseOpts) (*responsetypes.ProjectVersion, error) {
+	if versionID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+
+	version := &responsetypes.ProjectVersion{Released: true}
+	if !opts.ReleaseDate.IsZero() {
+		version.ReleaseDate = opts.ReleaseDate.Format(VERSION_DATE_FORMAT)
+	}
+	if opts.MoveUnfixedIssuesTo != "" {
+		// Jira expects the self URL of the version the issues are moved to
+		version.MoveUnfixedIssuesTo = s.versionURL(opts.MoveUnfixedIssuesTo)
+	}
+
+	return s.writeVersion(ctx, http.MethodPut, fmt.Sprintf(VERSION_DETAIL_ENDPOINT, versionID), version)
+}
+
+// UnreleaseVersion marks a released version as unreleased
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-put
+func (s *Service) UnreleaseVersion(ctx context.Context, versionID string) (*responsetypes.ProjectVersion, error) {
+	if versionID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+
+	// ProjectVersion omits a false Released, so the flag is sent on its own
+	payload := map[string]bool{"released": false}
+	return s.writeVersion(ctx, http.MethodPut, fmt.Sprintf(VERSION_DETAIL_ENDPOINT, versionID), payload)
+}
+
+// MergeVersion deletes a version, moving its issues to the target version
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-mergeto-moveissuesto-put
+func (s *Service) MergeVersion(ctx context.Context, versionID, targetVersionID string) error {
+	if versionID == "" {
+		return fmt.Errorf("version ID is required")
+	}
+	if targetVersionID == "" {
+		return fmt.Errorf("target version ID is required")
+	}
+
+	path := fmt.Sprintf(VERSION_MERGE_ENDPOINT, versionID, targetVersionID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// MoveVersion changes the position of a version in the version order of its project
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-move-post
+func (s *Service) MoveVersion(ctx context.Context, versionID string, opts VersionMoveOpts) (*responsetypes.ProjectVersion, error) {
+	if versionID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+	if (opts.After == "") == (opts.Position == "") {
+		return nil, fmt.Errorf("exactly one of after and position is required")
+	}
+
+	payload := map[string]string{}
+	if opts.After != "" {
+		payload["after"] = s.versionURL(opts.After)
+	} else {
+		payload["position"] = opts.Position
+	}
+
+	return s.writeVersion(ctx, http.MethodPost, fmt.Sprintf(VERSION_MOVE_ENDPOINT, versionID), payload)
+}
+
+// DeleteVersion deletes a version, optionally replacing it on the issues and custom fields that reference it
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-removeandswap-post
+func (s *Service) DeleteVersion(ctx context.Context, versionID string, opts VersionDeleteOpts) error {
+	if versionID == "" {
+		return fmt.Errorf("version ID is required")
+	}
+
+	// Jira expects numeric IDs in the body, json.Number writes them unquoted
+	type customFieldReplacement struct {
+		CustomFieldID json.Number `json:"customFieldId"`
+		MoveTo        json.Number `json:"moveTo"`
+	}
+	payload := struct {
+		MoveFixIssuesTo            json.Number              `json:"moveFixIssuesTo,omitempty"`
+		MoveAffectedIssuesTo       json.Number              `json:"moveAffectedIssuesTo,omitempty"`
+		CustomFieldReplacementList []customFieldReplacement `json:"customFieldReplacementList,omitempty"`
+	}{
+		MoveFixIssuesTo:      json.Number(opts.MoveFixIssuesTo),
+		MoveAffectedIssuesTo: json.Number(opts.MoveAffectedIssuesTo),
+	}
+	for _, replacement := range opts.CustomFieldReplacements {
+		payload.CustomFieldReplacementList = append(payload.CustomFieldReplacementList, customFieldReplacement{
+			CustomFieldID: json.Number(strings.TrimPrefix(replacement.CustomFieldID, "customfield_")),
+			MoveTo:        json.Number(replacement.MoveTo),
+		})
+	}
+
+	path := fmt.Sprintf(VERSION_REMOVE_AND_SWAP_ENDPOINT, versionID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, payload)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, nil); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// GetVersionRelatedIssueCounts returns the number of issues whose fix version, affected version or
+// version custom fields are set to a version
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-relatedissuecounts-get
+func (s *Service) GetVersionRelatedIssueCounts(ctx context.Context, versionID string) (*responsetypes.VersionIssueCounts, error) {
+	if versionID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+
+	counts := new(responsetypes.VersionIssueCounts)
+	if err := s.getVersionCounts(ctx, fmt.Sprintf(VERSION_RELATED_ISSUE_COUNTS_ENDPOINT, versionID), counts); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetVersionUnresolvedIssueCount returns the number of issues and unresolved issues of a version
+// See: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-unresolvedissuecount-get
+func (s *Service) GetVersionUnresolvedIssueCount(ctx context.Context, versionID string) (*responsetypes.VersionUnresolvedIssueCount, error) {
+	if versionID == "" {
+		return nil, fmt.Errorf("version ID is required")
+	}
+
+	count := new(responsetypes.VersionUnresolvedIssueCount)
+	if err := s.getVersionCounts(ctx, fmt.Sprintf(VERSION_UNRESOLVED_ISSUE_COUNT_ENDPOINT, versionID), count); err != nil {
+		return nil, err
+	}
+
+	return count, nil
+}
+
+// writeVersion sends a version payload and decodes the version in the response
+func (s *Service) writeVersion(ctx context.Context, method, path string, payload interface{}) (*responsetypes.ProjectVersion, error) {
+	req, err := s.client.NewRequest(ctx, method, path, payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	version := new(responsetypes.ProjectVersion)
+	if err := s.client.Do(req, version); err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+
+	return version, nil
+}
+
+// getVersionCounts decodes the issue counts of a version into v
+func (s *Service) getVersionCounts(ctx context.Context, path string, v interface{}) error {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	if err := s.client.Do(req, v); err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+
+	return nil
+}
+
+// versionURL returns the self URL of a version, the form Jira expects when a version references another
+func (s *Service) versionURL(versionID string) string {
+	return s.client.BaseURL() + fmt.Sprintf(VERSION_DETAIL_ENDPOINT, versionID)
+}
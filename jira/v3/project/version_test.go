@@ -0,0 +1,515 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+	"github.com/ducminhgd/go-atlassian/jira/v3/responsetypes"
+)
+
+func TestListVersions(t *testing.T) {
+	tests := []struct {
+		name           string
+		projectIDOrKey string
+		opts           VersionListOpts
+		wantURL        string
+		wantErr        bool
+		statusCode     int
+	}{
+		{
+			name:           "no options",
+			projectIDOrKey: "TEST",
+			wantURL:        "/rest/api/3/project/TEST/version",
+			statusCode:     http.StatusOK,
+		},
+		{
+			name:           "with options",
+			projectIDOrKey: "TEST",
+			opts: VersionListOpts{
+				StartAt:    50,
+				MaxResults: 25,
+				OrderBy:    "-releaseDate",
+				Query:      "v1",
+				Status:     []string{VERSION_STATUS_RELEASED, VERSION_STATUS_ARCHIVED},
+				Expand:     []string{VERSION_EXPAND_ISSUES_STATUS},
+			},
+			wantURL:    "/rest/api/3/project/TEST/version?expand=issuesstatus&maxResults=25&orderBy=-releaseDate&query=v1&startAt=50&status=released%2Carchived",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:           "not found",
+			projectIDOrKey: "NOPE",
+			wantURL:        "/rest/api/3/project/NOPE/version",
+			wantErr:        true,
+			statusCode:     http.StatusNotFound,
+		},
+		{
+			name:    "missing project",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := responsetypes.ProjectVersionListResponse{
+				Total:  1,
+				IsLast: true,
+				Values: []responsetypes.ProjectVersion{{ID: "10000", Name: "v1.0"}},
+			}
+			service, done := newTestService(t, http.MethodGet, tt.wantURL, tt.statusCode, response, nil)
+			defer done()
+
+			page, err := service.ListVersions(context.Background(), tt.projectIDOrKey, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListVersions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(page.Values) != 1 || page.Values[0].Name != "v1.0") {
+				t.Errorf("ListVersions() values = %+v, want version v1.0", page.Values)
+			}
+		})
+	}
+}
+
+func TestListAllVersions(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get("startAt")
+		starts = append(starts, start)
+
+		page := responsetypes.ProjectVersionListResponse{Total: 3}
+		switch start {
+		case "":
+			page.Values = []responsetypes.ProjectVersion{{ID: "1"}, {ID: "2"}}
+		case "2":
+			page.Values = []responsetypes.ProjectVersion{{ID: "3"}}
+			page.IsLast = true
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	service := NewService(&http.Client{}, server.URL, auth.NewBasicAuth("testuser", "secret123"))
+	versions, err := service.ListAllVersions(context.Background(), "TEST", VersionListOpts{MaxResults: 2})
+	if err != nil {
+		t.Fatalf("ListAllVersions() error = %v", err)
+	}
+
+	if len(versions) != 3 || versions[2].ID != "3" {
+		t.Errorf("ListAllVersions() = %+v, want versions 1, 2 and 3", versions)
+	}
+	if strings.Join(starts, ",") != ",2" {
+		t.Errorf("ListAllVersions() requested startAt %q, want \"\" then \"2\"", starts)
+	}
+}
+
+func TestGetVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		versionID  string
+		expand     []string
+		wantURL    string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "success",
+			versionID:  "10000",
+			wantURL:    "/rest/api/3/version/10000",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "with expand",
+			versionID:  "10000",
+			expand:     []string{VERSION_EXPAND_OPERATIONS, VERSION_EXPAND_DRIVER},
+			wantURL:    "/rest/api/3/version/10000?expand=operations%2Cdriver",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "not found",
+			versionID:  "99999",
+			wantURL:    "/rest/api/3/version/99999",
+			wantErr:    true,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:    "missing version ID",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, done := newTestService(t, http.MethodGet, tt.wantURL, tt.statusCode, responsetypes.ProjectVersion{ID: tt.versionID, Name: "v1.0"}, nil)
+			defer done()
+
+			version, err := service.GetVersion(context.Background(), tt.versionID, tt.expand)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && version.ID != tt.versionID {
+				t.Errorf("GetVersion() ID = %v, want %v", version.ID, tt.versionID)
+			}
+		})
+	}
+}
+
+func TestCreateVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    *responsetypes.ProjectVersion
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "success",
+			version:    &responsetypes.ProjectVersion{Name: "v1.0", ProjectID: "10000", StartDate: "2026-01-05"},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name:       "duplicate name",
+			version:    &responsetypes.ProjectVersion{Name: "v1.0", ProjectID: "10000"},
+			wantErr:    true,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "missing name",
+			version: &responsetypes.ProjectVersion{ProjectID: "10000"},
+			wantErr: true,
+		},
+		{
+			name:    "missing project ID",
+			version: &responsetypes.ProjectVersion{Name: "v1.0"},
+			wantErr: true,
+		},
+		{
+			name:    "nil version",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(t *testing.T, body map[string]interface{}) {
+				if body["name"] != "v1.0" || body["projectId"] != "10000" {
+					t.Errorf("body = %v, want name and projectId", body)
+				}
+			}
+			service, done := newTestService(t, http.MethodPost, "/rest/api/3/version", tt.statusCode, responsetypes.ProjectVersion{ID: "10001", Name: "v1.0"}, check)
+			defer done()
+
+			version, err := service.CreateVersion(context.Background(), tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && version.ID != "10001" {
+				t.Errorf("CreateVersion() ID = %v, want 10001", version.ID)
+			}
+		})
+	}
+}
+
+func TestUpdateVersion(t *testing.T) {
+	check := func(t *testing.T, body map[string]interface{}) {
+		if body["description"] != "First release" {
+			t.Errorf("body = %v, want description", body)
+		}
+		if _, ok := body["released"]; ok {
+			t.Errorf("body = %v, want no released flag", body)
+		}
+	}
+	service, done := newTestService(t, http.MethodPut, "/rest/api/3/version/10000", http.StatusOK, responsetypes.ProjectVersion{ID: "10000", Description: "First release"}, check)
+	defer done()
+
+	version, err := service.UpdateVersion(context.Background(), &responsetypes.ProjectVersion{ID: "10000", Description: "First release"})
+	if err != nil {
+		t.Fatalf("UpdateVersion() error = %v", err)
+	}
+	if version.Description != "First release" {
+		t.Errorf("UpdateVersion() description = %v, want First release", version.Description)
+	}
+
+	if _, err := service.UpdateVersion(context.Background(), &responsetypes.ProjectVersion{Name: "v1.0"}); err == nil {
+		t.Error("UpdateVersion() without ID error = nil, want error")
+	}
+}
+
+func TestReleaseVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		versionID string
+		opts      VersionReleaseOpts
+		wantBody  map[string]interface{}
+		wantErr   bool
+	}{
+		{
+			name:      "release only",
+			versionID: "10000",
+			wantBody:  map[string]interface{}{"released": true},
+		},
+		{
+			name:      "with date and unfixed issues",
+			versionID: "10000",
+			opts: VersionReleaseOpts{
+				ReleaseDate:         time.Date(2026, 3, 14, 16, 30, 0, 0, time.UTC),
+				MoveUnfixedIssuesTo: "10001",
+			},
+			wantBody: map[string]interface{}{
+				"released":            true,
+				"releaseDate":         "2026-03-14",
+				"moveUnfixedIssuesTo": "/rest/api/3/version/10001",
+			},
+		},
+		{
+			name:    "missing version ID",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var serverURL string
+			check := func(t *testing.T, body map[string]interface{}) {
+				if len(body) != len(tt.wantBody) {
+					t.Errorf("body = %v, want %v", body, tt.wantBody)
+				}
+				for key, want := range tt.wantBody {
+					if key == "moveUnfixedIssuesTo" {
+						want = serverURL + want.(string)
+					}
+					if body[key] != want {
+						t.Errorf("body[%s] = %v, want %v", key, body[key], want)
+					}
+				}
+			}
+			service, done := newTestService(t, http.MethodPut, "/rest/api/3/version/"+tt.versionID, http.StatusOK, responsetypes.ProjectVersion{ID: tt.versionID, Released: true}, check)
+			defer done()
+			serverURL = service.client.BaseURL()
+
+			version, err := service.ReleaseVersion(context.Background(), tt.versionID, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReleaseVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !version.Released {
+				t.Error("ReleaseVersion() released = false, want true")
+			}
+		})
+	}
+}
+
+func TestUnreleaseVersion(t *testing.T) {
+	check := func(t *testing.T, body map[string]interface{}) {
+		if released, ok := body["released"]; !ok || released != false || len(body) != 1 {
+			t.Errorf("body = %v, want {released: false}", body)
+		}
+	}
+	service, done := newTestService(t, http.MethodPut, "/rest/api/3/version/10000", http.StatusOK, responsetypes.ProjectVersion{ID: "10000"}, check)
+	defer done()
+
+	if _, err := service.UnreleaseVersion(context.Background(), "10000"); err != nil {
+		t.Fatalf("UnreleaseVersion() error = %v", err)
+	}
+	if _, err := service.UnreleaseVersion(context.Background(), ""); err == nil {
+		t.Error("UnreleaseVersion() without ID error = nil, want error")
+	}
+}
+
+func TestMergeVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		versionID  string
+		targetID   string
+		wantErr    bool
+		statusCode int
+	}{
+		{
+			name:       "success",
+			versionID:  "10000",
+			targetID:   "10001",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "not found",
+			versionID:  "10000",
+			targetID:   "10001",
+			wantErr:    true,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:     "missing version ID",
+			targetID: "10001",
+			wantErr:  true,
+		},
+		{
+			name:      "missing target ID",
+			versionID: "10000",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, done := newTestService(t, http.MethodPut, "/rest/api/3/version/10000/mergeto/10001", tt.statusCode, nil, nil)
+			defer done()
+
+			err := service.MergeVersion(context.Background(), tt.versionID, tt.targetID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MergeVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMoveVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     VersionMoveOpts
+		wantKey  string
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name:     "to position",
+			opts:     VersionMoveOpts{Position: VERSION_POSITION_FIRST},
+			wantKey:  "position",
+			wantBody: "First",
+		},
+		{
+			name:     "after version",
+			opts:     VersionMoveOpts{After: "10002"},
+			wantKey:  "after",
+			wantBody: "/rest/api/3/version/10002",
+		},
+		{
+			name:    "neither",
+			wantErr: true,
+		},
+		{
+			name:    "both",
+			opts:    VersionMoveOpts{After: "10002", Position: VERSION_POSITION_LAST},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var serverURL string
+			check := func(t *testing.T, body map[string]interface{}) {
+				want := tt.wantBody
+				if tt.wantKey == "after" {
+					want = serverURL + want
+				}
+				if len(body) != 1 || body[tt.wantKey] != want {
+					t.Errorf("body = %v, want {%s: %s}", body, tt.wantKey, want)
+				}
+			}
+			service, done := newTestService(t, http.MethodPost, "/rest/api/3/version/10000/move", http.StatusOK, responsetypes.ProjectVersion{ID: "10000"}, check)
+			defer done()
+			serverURL = service.client.BaseURL()
+
+			_, err := service.MoveVersion(context.Background(), "10000", tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MoveVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		versionID string
+		opts      VersionDeleteOpts
+		wantBody  string
+		wantErr   bool
+	}{
+		{
+			name:      "without replacements",
+			versionID: "10000",
+			wantBody:  `{}`,
+		},
+		{
+			name:      "with replacements",
+			versionID: "10000",
+			opts: VersionDeleteOpts{
+				MoveFixIssuesTo:      "10001",
+				MoveAffectedIssuesTo: "10002",
+				CustomFieldReplacements: []VersionCustomFieldReplacement{
+					{CustomFieldID: "customfield_10050", MoveTo: "10001"},
+				},
+			},
+			wantBody: `{"moveFixIssuesTo":10001,"moveAffectedIssuesTo":10002,"customFieldReplacementList":[{"customFieldId":10050,"moveTo":10001}]}`,
+		},
+		{
+			name:    "missing version ID",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/version/10000/removeAndSwap" {
+					t.Errorf("request = %s %s, want POST /rest/api/3/version/10000/removeAndSwap", r.Method, r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != tt.wantBody {
+					t.Errorf("body = %s, want %s", got, tt.wantBody)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			service := NewService(&http.Client{}, server.URL, auth.NewBasicAuth("testuser", "secret123"))
+			err := service.DeleteVersion(context.Background(), tt.versionID, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetVersionIssueCounts(t *testing.T) {
+	related := responsetypes.VersionIssueCounts{
+		IssuesFixedCount:    4,
+		IssuesAffectedCount: 2,
+		CustomFieldUsage: []responsetypes.VersionUsageInCustomField{
+			{FieldName: "Target", CustomFieldID: 10050, IssueCountWithVersionInCustomField: 1},
+		},
+	}
+	service, done := newTestService(t, http.MethodGet, "/rest/api/3/version/10000/relatedIssueCounts", http.StatusOK, related, nil)
+	counts, err := service.GetVersionRelatedIssueCounts(context.Background(), "10000")
+	done()
+	if err != nil {
+		t.Fatalf("GetVersionRelatedIssueCounts() error = %v", err)
+	}
+	if counts.IssuesFixedCount != 4 || counts.IssuesAffectedCount != 2 || len(counts.CustomFieldUsage) != 1 {
+		t.Errorf("GetVersionRelatedIssueCounts() = %+v, want %+v", counts, related)
+	}
+
+	unresolved := responsetypes.VersionUnresolvedIssueCount{IssuesUnresolvedCount: 3, IssuesCount: 7}
+	service, done = newTestService(t, http.MethodGet, "/rest/api/3/version/10000/unresolvedIssueCount", http.StatusOK, unresolved, nil)
+	count, err := service.GetVersionUnresolvedIssueCount(context.Background(), "10000")
+	done()
+	if err != nil {
+		t.Fatalf("GetVersionUnresolvedIssueCount() error = %v", err)
+	}
+	if *count != unresolved {
+		t.Errorf("GetVersionUnresolvedIssueCount() = %+v, want %+v", count, unresolved)
+	}
+
+	if _, err := service.GetVersionRelatedIssueCounts(context.Background(), ""); err == nil {
+		t.Error("GetVersionRelatedIssueCounts() without ID error = nil, want error")
+	}
+	if _, err := service.GetVersionUnresolvedIssueCount(context.Background(), ""); err == nil {
+		t.Error("GetVersionUnresolvedIssueCount() without ID error = nil, want error")
+	}
+}
@@ -1,5 +1,12 @@
 package responsetypes
 
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
 // AtlassianDocumentFormat represents the Atlassian Document Format (ADF) structure
 type AtlassianDocumentFormat struct {
 	Type    string         `json:"type"`
@@ -54,7 +61,7 @@ type NodeAttrs struct {
 	Language      string                 `json:"language,omitempty"`
 	LocalID       string                 `json:"localId,omitempty"`
 	Layout        string                 `json:"layout,omitempty"`
-	Width         int                    `json:"width,omitempty"`
+	Width         float64                `json:"width,omitempty"`
 	PanelType     string                 `json:"panelType,omitempty"`
 	ExtensionType string                 `json:"extensionType,omitempty"`
 	ExtensionKey  string                 `json:"extensionKey,omitempty"`
@@ -66,40 +73,110 @@ type NodeAttrs struct {
 	State         string                 `json:"state,omitempty"`
 	Title         string                 `json:"title,omitempty"`
 	URL           string                 `json:"url,omitempty"`
+	Color         string                 `json:"color,omitempty"`
+	Style         string                 `json:"style,omitempty"`
+	Order         int                    `json:"order,omitempty"`
+	Type          string                 `json:"type,omitempty"`
+	Height        float64                `json:"height,omitempty"`
+	Alt           string                 `json:"alt,omitempty"`
+	UserType      string                 `json:"userType,omitempty"`
+	Background    string                 `json:"background,omitempty"`
+	Colspan       int                    `json:"colspan,omitempty"`
+	Rowspan       int                    `json:"rowspan,omitempty"`
+	Colwidth      []float64              `json:"colwidth,omitempty"`
+
+	IsNumberColumnEnabled bool `json:"isNumberColumnEnabled,omitempty"`
+}
+
+// UnmarshalJSON decodes node attributes, skipping the attributes whose value doesn't fit their field
+// so that one unexpected attribute doesn't make a whole document unreadable
+func (a *NodeAttrs) UnmarshalJSON(data []byte) error {
+	type nodeAttrs NodeAttrs
+	return unmarshalAttrs(data, (*nodeAttrs)(a))
+}
+
+// UnmarshalJSON decodes mark attributes, skipping the attributes whose value doesn't fit their field
+func (a *MarkAttrs) UnmarshalJSON(data []byte) error {
+	type markAttrs MarkAttrs
+	return unmarshalAttrs(data, (*markAttrs)(a))
+}
+
+// unmarshalAttrs decodes a JSON object into the struct pointed to by v. When a value has an unexpected type,
+// the object is decoded again one field at a time, leaving the fields that fail to decode zero.
+func unmarshalAttrs(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	var typeErr *json.UnmarshalTypeError
+	if err == nil || !errors.As(err, &typeErr) {
+		return err
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rv.SetZero()
+	for i := range rv.NumField() {
+		name, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("json"), ",")
+		if raw, ok := values[name]; ok {
+			_ = json.Unmarshal(raw, rv.Field(i).Addr().Interface())
+		}
+	}
+	return nil
 }
 
 // Common ADF node types constants
 const (
-	NodeTypeDoc         = "doc"
-	NodeTypeParagraph   = "paragraph"
-	NodeTypeText        = "text"
-	NodeTypeHeading     = "heading"
-	NodeTypeBlockquote  = "blockquote"
-	NodeTypeBulletList  = "bulletList"
-	NodeTypeOrderedList = "orderedList"
-	NodeTypeListItem    = "listItem"
-	NodeTypeCodeBlock   = "codeBlock"
-	NodeTypeMediaSingle = "mediaSingle"
-	NodeTypeMedia       = "media"
-	NodeTypeHardBreak   = "hardBreak"
-	NodeTypeMention     = "mention"
-	NodeTypeEmoji       = "emoji"
-	NodeTypeStatus      = "status"
-	NodeTypeTable       = "table"
-	NodeTypeTableRow    = "tableRow"
-	NodeTypeTableCell   = "tableCell"
-	NodeTypeTableHeader = "tableHeader"
+	NodeTypeDoc             = "doc"
+	NodeTypeParagraph       = "paragraph"
+	NodeTypeText            = "text"
+	NodeTypeHeading         = "heading"
+	NodeTypeBlockquote      = "blockquote"
+	NodeTypeBulletList      = "bulletList"
+	NodeTypeOrderedList     = "orderedList"
+	NodeTypeListItem        = "listItem"
+	NodeTypeCodeBlock       = "codeBlock"
+	NodeTypeMediaSingle     = "mediaSingle"
+	NodeTypeMedia           = "media"
+	NodeTypeHardBreak       = "hardBreak"
+	NodeTypeMention         = "mention"
+	NodeTypeEmoji           = "emoji"
+	NodeTypeStatus          = "status"
+	NodeTypeTable           = "table"
+	NodeTypeTableRow        = "tableRow"
+	NodeTypeTableCell       = "tableCell"
+	NodeTypeTableHeader     = "tableHeader"
+	NodeTypePanel           = "panel"
+	NodeTypeRule            = "rule"
+	NodeTypeDate            = "date"
+	NodeTypeInlineCard      = "inlineCard"
+	NodeTypeBlockCard       = "blockCard"
+	NodeTypeEmbedCard       = "embedCard"
+	NodeTypeMediaGroup      = "mediaGroup"
+	NodeTypeMediaInline     = "mediaInline"
+	NodeTypeExpand          = "expand"
+	NodeTypeNestedExpand    = "nestedExpand"
+	NodeTypeTaskList        = "taskList"
+	NodeTypeTaskItem        = "taskItem"
+	NodeTypeDecisionList    = "decisionList"
+	NodeTypeDecisionItem    = "decisionItem"
+	NodeTypeExtension       = "extension"
+	NodeTypeInlineExtension = "inlineExtension"
+	NodeTypeBodiedExtension = "bodiedExtension"
 )
 
 // Common mark types constants
 const (
-	MarkTypeStrong      = "strong"
-	MarkTypeEm          = "em"
-	MarkTypeStrike      = "strike"
-	MarkTypeUnderline   = "underline"
-	MarkTypeCode        = "code"
-	MarkTypeLink        = "link"
-	MarkTypeTextColor   = "textColor"
-	MarkTypeAlignment   = "alignment"
-	MarkTypeIndentation = "indentation"
+	MarkTypeStrong          = "strong"
+	MarkTypeEm              = "em"
+	MarkTypeStrike          = "strike"
+	MarkTypeUnderline       = "underline"
+	MarkTypeCode            = "code"
+	MarkTypeLink            = "link"
+	MarkTypeTextColor       = "textColor"
+	MarkTypeAlignment       = "alignment"
+	MarkTypeIndentation     = "indentation"
+	MarkTypeSubSup          = "subsup"
+	MarkTypeBackgroundColor = "backgroundColor"
 )
@@ -3,10 +3,10 @@ package responsetypes
 // ProjectComponent represents a component within a project, used to organize and categorize issues
 type ProjectComponent struct {
 	// The unique identifier of the component
-	ID string `json:"id"`
+	ID string `json:"id,omitempty"`
 
-	// The name of the component
-	Name string `json:"name"`
+	// The name of the component. Required when creating a component. Optional when updating a component.
+	Name string `json:"name,omitempty"`
 
 	// A description of the component
 	Description string `json:"description,omitempty"`
@@ -14,7 +14,30 @@ type ProjectComponent struct {
 	// The user assigned as the component's lead
 	Lead *User `json:"lead,omitempty"`
 
-	// The key of the project this component belongs to
+	// The account ID of the component's lead. Optional when creating or updating a component.
+	LeadAccountID string `json:"leadAccountId,omitempty"`
+
+	// The nominal user type used to determine the assignee for issues created with this component. Can take the following values:
+	// - `PROJECT_DEFAULT` the assignee to any issues created with this component is nominally the default assignee for the project
+	// - `COMPONENT_LEAD` the assignee to any issues created with this component is nominally the lead for the component
+	// - `PROJECT_LEAD` the assignee to any issues created with this component is nominally the lead for the project
+	// - `UNASSIGNED` an assignee is not set for issues created with this component
+	// Optional when creating or updating a component.
+	AssigneeType string `json:"assigneeType,omitempty"`
+
+	// The details of the nominal assignee of issues created with this component
+	Assignee *User `json:"assignee,omitempty"`
+
+	// The type of the assignee actually given to issues created with this component, when the nominal assignee is not assignable
+	RealAssigneeType string `json:"realAssigneeType,omitempty"`
+
+	// The user actually assigned to issues created with this component
+	RealAssignee *User `json:"realAssignee,omitempty"`
+
+	// Whether a user is associated with assigneeType, e.g. false when assigneeType is COMPONENT_LEAD and the component has no lead
+	IsAssigneeTypeValid bool `json:"isAssigneeTypeValid,omitempty"`
+
+	// The key of the project this component belongs to. Required when creating a component. Can't be updated.
 	Project string `json:"project,omitempty"`
 
 	// The numeric ID of the project this component belongs to
@@ -23,3 +46,44 @@ type ProjectComponent struct {
 	// The REST API URL for this component resource
 	Self string `json:"self,omitempty"`
 }
+
+// ProjectComponentWithIssueCount represents a component with the number of issues it is set on
+type ProjectComponentWithIssueCount struct {
+	ProjectComponent
+
+	// Count of issues for the component
+	IssueCount int `json:"issueCount"`
+}
+
+// ProjectComponentListResponse represents a paginated list of the components of a project
+type ProjectComponentListResponse struct {
+	// The REST API URL for this resource
+	Self string `json:"self,omitempty"`
+
+	// The URL for the next page of results
+	NextPage string `json:"nextPage,omitempty"`
+
+	// The maximum number of results per page
+	MaxResults int `json:"maxResults,omitempty"`
+
+	// The index of the first item returned in the page
+	StartAt int `json:"startAt,omitempty"`
+
+	// The total number of items available
+	Total int `json:"total,omitempty"`
+
+	// Whether this is the last page of results
+	IsLast bool `json:"isLast,omitempty"`
+
+	// The list of components in this page
+	Values []ProjectComponentWithIssueCount `json:"values,omitempty"`
+}
+
+// ComponentIssueCount represents the number of issues a component is set on
+type ComponentIssueCount struct {
+	// The URL for this count of issues for a component
+	Self string `json:"self,omitempty"`
+
+	// The count of issues assigned to a component
+	IssueCount int `json:"issueCount"`
+}
@@ -0,0 +1,49 @@
+package responsetypes
+
+// Field represents a system or custom field of issues
+type Field struct {
+	// The ID of the field, e.g. "summary" or "customfield_10016"
+	ID string `json:"id,omitempty"`
+
+	// The key of the field
+	Key string `json:"key,omitempty"`
+
+	// The display name of the field, e.g. "Story Points"
+	Name string `json:"name,omitempty"`
+
+	// Whether the field is a custom field
+	Custom bool `json:"custom,omitempty"`
+
+	// Whether the content of the field can be used to order lists
+	Orderable bool `json:"orderable,omitempty"`
+
+	// Whether the field can be used as a column on the issue navigator
+	Navigable bool `json:"navigable,omitempty"`
+
+	// Whether the content of the field can be searched
+	Searchable bool `json:"searchable,omitempty"`
+
+	// The names that can be used to reference the field in an advanced search
+	ClauseNames []string `json:"clauseNames,omitempty"`
+
+	// The data schema of the field
+	Schema *FieldSchema `json:"schema,omitempty"`
+}
+
+// FieldSchema describes the type of the values of a field
+type FieldSchema struct {
+	// The data type of the field, e.g. "string", "number", "array" or "user"
+	Type string `json:"type,omitempty"`
+
+	// When the data type is an array, the type of the items
+	Items string `json:"items,omitempty"`
+
+	// When the field is a system field, the name of the field
+	System string `json:"system,omitempty"`
+
+	// When the field is a custom field, the URI of the field type
+	Custom string `json:"custom,omitempty"`
+
+	// When the field is a custom field, the ID of the custom field
+	CustomID int64 `json:"customId,omitempty"`
+}
@@ -0,0 +1,97 @@
+package responsetypes
+
+// ProjectRole represents a project role, either as a global definition or with its actors in a project
+type ProjectRole struct {
+	// The URL of the project role
+	Self string `json:"self,omitempty"`
+
+	// The name of the project role
+	Name string `json:"name,omitempty"`
+
+	// The ID of the project role
+	ID int64 `json:"id,omitempty"`
+
+	// The description of the project role
+	Description string `json:"description,omitempty"`
+
+	// The list of users and groups who hold the role in the project
+	Actors []RoleActor `json:"actors,omitempty"`
+
+	// The scope of the role, not set for roles that are available to every project
+	Scope *RoleScope `json:"scope,omitempty"`
+
+	// The translated name of the project role
+	TranslatedName string `json:"translatedName,omitempty"`
+
+	// Whether the calling user is part of this role
+	CurrentUserRole bool `json:"currentUserRole,omitempty"`
+
+	// Whether this role is the admin role for the project
+	Admin bool `json:"admin,omitempty"`
+
+	// Whether this role is the default role for the project
+	Default bool `json:"default,omitempty"`
+
+	// Whether the roles are configurable for this project
+	RoleConfigurable bool `json:"roleConfigurable,omitempty"`
+}
+
+// RoleActor represents a user or a group holding a project role
+type RoleActor struct {
+	// The ID of the role actor
+	ID int64 `json:"id,omitempty"`
+
+	// The display name of the role actor. For users, depending on the user's privacy setting, this may return an alternative value for the user's name
+	DisplayName string `json:"displayName,omitempty"`
+
+	// The type of role actor, "atlassian-user-role-actor" or "atlassian-group-role-actor"
+	Type string `json:"type,omitempty"`
+
+	// The avatar of the role actor
+	AvatarURL string `json:"avatarUrl,omitempty"`
+
+	// The user, set when the actor is a user
+	ActorUser *RoleActorUser `json:"actorUser,omitempty"`
+
+	// The group, set when the actor is a group
+	ActorGroup *RoleActorGroup `json:"actorGroup,omitempty"`
+}
+
+// RoleActorUser represents the user of a role actor
+type RoleActorUser struct {
+	// The account ID of the user, which uniquely identifies the user across all Atlassian products
+	AccountID string `json:"accountId,omitempty"`
+}
+
+// RoleActorGroup represents the group of a role actor
+type RoleActorGroup struct {
+	// The display name of the group
+	DisplayName string `json:"displayName,omitempty"`
+
+	// The name of the group
+	Name string `json:"name,omitempty"`
+
+	// The ID of the group
+	GroupID string `json:"groupId,omitempty"`
+}
+
+// RoleScope represents the projects a project role is available to
+type RoleScope struct {
+	// The type of scope, "PROJECT" or "TEMPLATE"
+	Type string `json:"type,omitempty"`
+
+	// The project the role is available to
+	Project *RoleScopeProject `json:"project,omitempty"`
+}
+
+// RoleScopeProject represents the project of a role scope
+type RoleScopeProject struct {
+	// The ID of the project
+	ID string `json:"id,omitempty"`
+
+	// The key of the project
+	Key string `json:"key,omitempty"`
+
+	// The name of the project
+	Name string `json:"name,omitempty"`
+}
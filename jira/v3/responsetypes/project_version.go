@@ -84,3 +84,69 @@ type SimpleLink struct {
 	Title      string `json:"title,omitempty"`
 	Weight     int    `json:"weight,omitempty"`
 }
+
+// ProjectVersionListResponse represents a paginated list of the versions of a project
+type ProjectVersionListResponse struct {
+	// The REST API URL for this resource
+	Self string `json:"self,omitempty"`
+
+	// The URL for the next page of results
+	NextPage string `json:"nextPage,omitempty"`
+
+	// The maximum number of results per page
+	MaxResults int `json:"maxResults,omitempty"`
+
+	// The index of the first item returned in the page
+	StartAt int `json:"startAt,omitempty"`
+
+	// The total number of items available
+	Total int `json:"total,omitempty"`
+
+	// Whether this is the last page of results
+	IsLast bool `json:"isLast,omitempty"`
+
+	// The list of versions in this page
+	Values []ProjectVersion `json:"values,omitempty"`
+}
+
+// VersionIssueCounts represents the number of issues related to a version
+type VersionIssueCounts struct {
+	// The URL of these count details
+	Self string `json:"self,omitempty"`
+
+	// Count of issues where the fixVersion is set to the version
+	IssuesFixedCount int `json:"issuesFixedCount"`
+
+	// Count of issues where the affectedVersion is set to the version
+	IssuesAffectedCount int `json:"issuesAffectedCount"`
+
+	// Count of issues where a version custom field is set to the version
+	IssueCountWithCustomFieldsShowingVersion int `json:"issueCountWithCustomFieldsShowingVersion"`
+
+	// The version custom fields that reference the version, with their issue counts
+	CustomFieldUsage []VersionUsageInCustomField `json:"customFieldUsage,omitempty"`
+}
+
+// VersionUsageInCustomField represents the use of a version in a version custom field
+type VersionUsageInCustomField struct {
+	// The name of the custom field
+	FieldName string `json:"fieldName,omitempty"`
+
+	// The ID of the custom field
+	CustomFieldID int64 `json:"customFieldId,omitempty"`
+
+	// Count of issues where the custom field is set to the version
+	IssueCountWithVersionInCustomField int `json:"issueCountWithVersionInCustomField"`
+}
+
+// VersionUnresolvedIssueCount represents the number of unresolved issues of a version
+type VersionUnresolvedIssueCount struct {
+	// The URL of these count details
+	Self string `json:"self,omitempty"`
+
+	// Count of unresolved issues
+	IssuesUnresolvedCount int `json:"issuesUnresolvedCount"`
+
+	// Count of issues
+	IssuesCount int `json:"issuesCount"`
+}
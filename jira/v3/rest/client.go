@@ -0,0 +1,195 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+// DefaultUserAgent is the User-Agent header sent when none is configured
+const DefaultUserAgent = "go-atlassian"
+
+// Client is the request pipeline shared by every API group service.
+// It owns the HTTP client, the base URL and the authenticator, so that every
+// service builds and sends requests the same way.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	auth       auth.Authenticator
+	userAgent  string
+}
+
+// NewClient creates a new request pipeline.
+// A nil httpClient falls back to http.DefaultClient and a nil authenticator sends anonymous requests.
+func NewClient(httpClient *http.Client, baseURL string, authenticator auth.Authenticator) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		auth:       authenticator,
+		userAgent:  DefaultUserAgent,
+	}
+}
+
+// NormalizeBaseURL validates a Jira site URL and strips trailing slashes.
+// A URL without scheme is assumed to be https.
+func NormalizeBaseURL(baseURL string) (string, error) {
+	baseURL = strings.TrimSpace(baseURL)
+	if baseURL == "" {
+		return "", fmt.Errorf("base URL is required")
+	}
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "https://" + baseURL
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid base URL scheme %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid base URL: missing host")
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// SetUserAgent overrides the User-Agent header sent with every request
+func (c *Client) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// BaseURL returns the base URL requests are sent to
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// HTTPClient returns the underlying HTTP client
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// Authenticator returns the authenticator used to sign requests
+func (c *Client) Authenticator() auth.Authenticator {
+	return c.auth
+}
+
+// NewRequest creates a new HTTP request with a JSON encoded body.
+// The path is relative to the base URL and may include a query string.
+func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	var buf io.Reader
+	if body != nil {
+		b := new(bytes.Buffer)
+		enc := json.NewEncoder(b)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(body); err != nil {
+			return nil, err
+		}
+		buf = b
+	}
+
+	req, err := c.NewRawRequest(ctx, method, path, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// NewRawRequest creates a new HTTP request whose body is sent as-is.
+// Callers are responsible for setting the Content-Type header.
+func (c *Client) NewRawRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	baseURL := c.baseURL
+	if rewriter, ok := c.auth.(auth.BaseURLRewriter); ok {
+		rewritten, err := rewriter.RewriteBaseURL(ctx, baseURL)
+		if err != nil {
+			return nil, err
+		}
+		baseURL = strings.TrimRight(rewritten, "/")
+	}
+
+	u, err := url.Parse(baseURL + path)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	if c.auth != nil {
+		if err := c.auth.AddAuthentication(req); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+// Do sends an HTTP request and decodes the JSON response into v.
+// Any status code outside of the 2xx range is returned as an *APIError.
+func (c *Client) Do(req *http.Request, v interface{}) error {
+	resp, err := c.DoRaw(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DoRaw sends an HTTP request and returns the response without decoding it.
+// Any status code outside of the 2xx range is returned as an *APIError, otherwise
+// the caller must close the response body.
+func (c *Client) DoRaw(req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CheckResponse returns an *APIError when the response status code is outside of the 2xx range
+func CheckResponse(resp *http.Response) error {
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	return newAPIError(resp)
+}
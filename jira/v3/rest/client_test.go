@@ -0,0 +1,187 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
+)
+
+func TestNormalizeBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "already normalized",
+			baseURL: "https://example.atlassian.net",
+			want:    "https://example.atlassian.net",
+		},
+		{
+			name:    "trailing slashes",
+			baseURL: "https://example.atlassian.net//",
+			want:    "https://example.atlassian.net",
+		},
+		{
+			name:    "missing scheme",
+			baseURL: "example.atlassian.net",
+			want:    "https://example.atlassian.net",
+		},
+		{
+			name:    "context path",
+			baseURL: " http://localhost:8080/jira/ ",
+			want:    "http://localhost:8080/jira",
+		},
+		{
+			name:    "empty",
+			baseURL: "",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			baseURL: "ftp://example.atlassian.net",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeBaseURL(tt.baseURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeBaseURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeBaseURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_NewRequest(t *testing.T) {
+	client := NewClient(nil, "https://example.atlassian.net/", auth.NewBasicAuth("user", "token"))
+
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "/rest/api/3/issue?notifyUsers=false", map[string]string{"summary": "<b>"})
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	if req.URL.String() != "https://example.atlassian.net/rest/api/3/issue?notifyUsers=false" {
+		t.Errorf("URL = %v", req.URL.String())
+	}
+	if req.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Content-Type = %v, want application/json", req.Header.Get("Content-Type"))
+	}
+	if req.Header.Get("Accept") != "application/json" {
+		t.Errorf("Accept = %v, want application/json", req.Header.Get("Accept"))
+	}
+	if req.Header.Get("User-Agent") != DefaultUserAgent {
+		t.Errorf("User-Agent = %v, want %v", req.Header.Get("User-Agent"), DefaultUserAgent)
+	}
+	if req.Header.Get("Authorization") == "" {
+		t.Error("Missing Authorization header")
+	}
+}
+
+func TestClient_NewRequest_Anonymous(t *testing.T) {
+	client := NewClient(nil, "https://example.atlassian.net", nil)
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/rest/api/3/serverInfo", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Errorf("Authorization = %v, want empty", req.Header.Get("Authorization"))
+	}
+	if req.Header.Get("Content-Type") != "" {
+		t.Errorf("Content-Type = %v, want empty for request without body", req.Header.Get("Content-Type"))
+	}
+}
+
+func TestClient_Do(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    bool
+	}{
+		{
+			name:       "ok",
+			statusCode: http.StatusOK,
+			body:       `{"key":"TEST-1"}`,
+		},
+		{
+			name:       "no content",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "not modified",
+			statusCode: http.StatusNotModified,
+			wantErr:    true,
+		},
+		{
+			name:       "bad request",
+			statusCode: http.StatusBadRequest,
+			body:       `{"errorMessages":["bad"]}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(nil, server.URL, nil)
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "/", nil)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+
+			var got map[string]interface{}
+			err = client.Do(req, &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.body != "" && !tt.wantErr {
+				var want map[string]interface{}
+				json.Unmarshal([]byte(tt.body), &want)
+				if got["key"] != want["key"] {
+					t.Errorf("Do() decoded = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+// gatewayAuth rewrites requests to an API gateway, like OAuth 2.0 apps do
+type gatewayAuth struct{}
+
+func (gatewayAuth) AddAuthentication(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer token")
+	return nil
+}
+
+func (gatewayAuth) RewriteBaseURL(ctx context.Context, baseURL string) (string, error) {
+	return "https://api.atlassian.com/ex/jira/cloud-123/", nil
+}
+
+func TestClient_NewRequest_BaseURLRewriter(t *testing.T) {
+	client := NewClient(nil, "https://example.atlassian.net", gatewayAuth{})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/rest/api/3/myself", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	if req.URL.String() != "https://api.atlassian.com/ex/jira/cloud-123/rest/api/3/myself" {
+		t.Errorf("URL = %v", req.URL.String())
+	}
+}
@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// RequestIDHeader is the header Jira uses to identify a request in its logs
+const RequestIDHeader = "X-AREQUESTID"
+
+// APIError is returned when Jira responds with a status code outside of the 2xx range
+type APIError struct {
+	// The HTTP status code of the response
+	StatusCode int
+
+	// The HTTP method of the request
+	Method string
+
+	// The URL of the request
+	URL string
+
+	// The request ID reported by Jira in the X-AREQUESTID header
+	RequestID string
+
+	// The list of error messages returned by Jira
+	ErrorMessages []string
+
+	// The errors returned by Jira for individual fields, keyed by field name
+	Errors map[string]string
+
+	// The raw response body, kept when it could not be decoded as a Jira error collection
+	Body string
+
+	// The response headers
+	Header http.Header
+}
+
+// errorCollection is the error payload returned by Jira
+type errorCollection struct {
+	ErrorMessages []string          `json:"errorMessages"`
+	Errors        map[string]string `json:"errors"`
+	Message       string            `json:"message"`
+}
+
+// newAPIError builds an APIError from an unsuccessful response
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(RequestIDHeader),
+		Header:     resp.Header,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.URL = resp.Request.URL.String()
+		}
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var collection errorCollection
+	if len(body) > 0 && json.Unmarshal(body, &collection) == nil {
+		apiErr.ErrorMessages = collection.ErrorMessages
+		apiErr.Errors = collection.Errors
+		if collection.Message != "" {
+			apiErr.ErrorMessages = append(apiErr.ErrorMessages, collection.Message)
+		}
+	}
+	if len(apiErr.ErrorMessages) == 0 && len(apiErr.Errors) == 0 {
+		apiErr.Body = strings.TrimSpace(string(body))
+	}
+
+	return apiErr
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "jira: %s %s: HTTP %d", e.Method, e.URL, e.StatusCode)
+
+	var details []string
+	details = append(details, e.ErrorMessages...)
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		details = append(details, fmt.Sprintf("%s: %s", field, e.Errors[field]))
+	}
+	if len(details) == 0 && e.Body != "" {
+		details = append(details, e.Body)
+	}
+	if len(details) > 0 {
+		fmt.Fprintf(&b, ": %s", strings.Join(details, "; "))
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request id: %s)", e.RequestID)
+	}
+
+	return b.String()
+}
+
+// hasStatus reports whether err is an APIError with one of the given status codes
+func hasStatus(err error, codes ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, code := range codes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBadRequest reports whether err is caused by an invalid request (400)
+func IsBadRequest(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized reports whether err is caused by missing or expired credentials (401)
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is caused by missing permissions (403)
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsNotFound reports whether err is caused by a resource that does not exist or is not visible (404)
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is caused by a conflicting resource state (409)
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is caused by Jira throttling the client (429)
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsServerError reports whether err is caused by a server side failure (5xx)
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name              string
+		statusCode        int
+		body              string
+		wantMessages      []string
+		wantErrors        map[string]string
+		wantBody          string
+		wantNotFound      bool
+		wantUnauthorized  bool
+		wantRateLimited   bool
+		wantServerError   bool
+		wantErrorContains string
+	}{
+		{
+			name:              "not found",
+			statusCode:        http.StatusNotFound,
+			body:              `{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`,
+			wantMessages:      []string{"Issue does not exist or you do not have permission to see it."},
+			wantNotFound:      true,
+			wantErrorContains: "HTTP 404: Issue does not exist",
+		},
+		{
+			name:              "field errors",
+			statusCode:        http.StatusBadRequest,
+			body:              `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}}`,
+			wantErrors:        map[string]string{"summary": "You must specify a summary of the issue."},
+			wantErrorContains: "summary: You must specify a summary of the issue.",
+		},
+		{
+			name:              "unauthorized plain body",
+			statusCode:        http.StatusUnauthorized,
+			body:              "Client must be authenticated to access this resource.",
+			wantBody:          "Client must be authenticated to access this resource.",
+			wantUnauthorized:  true,
+			wantErrorContains: "HTTP 401: Client must be authenticated",
+		},
+		{
+			name:              "rate limited with message",
+			statusCode:        http.StatusTooManyRequests,
+			body:              `{"message":"Rate limit exceeded"}`,
+			wantMessages:      []string{"Rate limit exceeded"},
+			wantRateLimited:   true,
+			wantErrorContains: "Rate limit exceeded",
+		},
+		{
+			name:            "server error",
+			statusCode:      http.StatusServiceUnavailable,
+			wantServerError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(RequestIDHeader, "req-123")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(nil, server.URL, nil)
+			req, _ := client.NewRequest(context.Background(), http.MethodGet, "/rest/api/3/issue/TEST-1", nil)
+			err := client.Do(req, nil)
+
+			// Wrap the error like services do, the chain must be kept
+			err = fmt.Errorf("error making request: %w", err)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("errors.As() failed for %T", err)
+			}
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("StatusCode = %v, want %v", apiErr.StatusCode, tt.statusCode)
+			}
+			if apiErr.Method != http.MethodGet {
+				t.Errorf("Method = %v, want GET", apiErr.Method)
+			}
+			if apiErr.URL != server.URL+"/rest/api/3/issue/TEST-1" {
+				t.Errorf("URL = %v", apiErr.URL)
+			}
+			if apiErr.RequestID != "req-123" {
+				t.Errorf("RequestID = %v, want req-123", apiErr.RequestID)
+			}
+			if strings.Join(apiErr.ErrorMessages, "|") != strings.Join(tt.wantMessages, "|") {
+				t.Errorf("ErrorMessages = %v, want %v", apiErr.ErrorMessages, tt.wantMessages)
+			}
+			for k, v := range tt.wantErrors {
+				if apiErr.Errors[k] != v {
+					t.Errorf("Errors[%q] = %v, want %v", k, apiErr.Errors[k], v)
+				}
+			}
+			if apiErr.Body != tt.wantBody {
+				t.Errorf("Body = %q, want %q", apiErr.Body, tt.wantBody)
+			}
+			if !strings.Contains(err.Error(), tt.wantErrorContains) {
+				t.Errorf("Error() = %q, want to contain %q", err.Error(), tt.wantErrorContains)
+			}
+			if !strings.Contains(err.Error(), "request id: req-123") {
+				t.Errorf("Error() = %q, want request id", err.Error())
+			}
+
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Errorf("IsNotFound() = %v, want %v", IsNotFound(err), tt.wantNotFound)
+			}
+			if IsUnauthorized(err) != tt.wantUnauthorized {
+				t.Errorf("IsUnauthorized() = %v, want %v", IsUnauthorized(err), tt.wantUnauthorized)
+			}
+			if IsRateLimited(err) != tt.wantRateLimited {
+				t.Errorf("IsRateLimited() = %v, want %v", IsRateLimited(err), tt.wantRateLimited)
+			}
+			if IsServerError(err) != tt.wantServerError {
+				t.Errorf("IsServerError() = %v, want %v", IsServerError(err), tt.wantServerError)
+			}
+		})
+	}
+}
+
+func TestIsNotFound_OtherErrors(t *testing.T) {
+	if IsNotFound(nil) {
+		t.Error("IsNotFound(nil) = true, want false")
+	}
+	if IsNotFound(errors.New("HTTP 404")) {
+		t.Error("IsNotFound() = true for plain error, want false")
+	}
+}
@@ -0,0 +1,264 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries used when RetryPolicy.MaxRetries is zero
+	DefaultMaxRetries = 4
+
+	// DefaultRetryBaseDelay is the initial backoff used when RetryPolicy.BaseDelay is zero
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+
+	// DefaultRetryMaxDelay is the backoff cap used when RetryPolicy.MaxDelay is zero
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// RetryPolicy configures how RetryTransport retries throttled and unavailable responses
+type RetryPolicy struct {
+	// Maximum number of retries after the first attempt. Defaults to DefaultMaxRetries, negative disables retries
+	MaxRetries int
+
+	// Initial delay of the exponential backoff. Defaults to DefaultRetryBaseDelay
+	BaseDelay time.Duration
+
+	// Maximum backoff between two attempts. Defaults to DefaultRetryMaxDelay.
+	// Delays requested by Jira through Retry-After or X-RateLimit-Reset are honored as-is.
+	MaxDelay time.Duration
+
+	// Status codes that trigger a retry. Defaults to 429 and 503
+	RetryableStatusCodes []int
+
+	// Whether requests with non-idempotent methods (POST, PATCH) are retried as well
+	RetryNonIdempotent bool
+
+	// Called before waiting for the next attempt, e.g. for logging
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a retry that is about to happen
+type RetryEvent struct {
+	// The request being retried
+	Request *http.Request
+
+	// The retry number, starting at 1
+	Attempt int
+
+	// The status code of the failed attempt, zero when it failed with a transport error
+	StatusCode int
+
+	// The transport error of the failed attempt, if any
+	Err error
+
+	// How long the transport waits before the next attempt
+	Wait time.Duration
+}
+
+// RetryStats counts the retries done for the requests sent with a context
+type RetryStats struct {
+	retries atomic.Int64
+}
+
+// Retries returns the number of retries done so far
+func (s *RetryStats) Retries() int {
+	return int(s.retries.Load())
+}
+
+type retryStatsKey struct{}
+
+// WithRetryStats returns a context that records the retries of every request sent with it
+func WithRetryStats(ctx context.Context) (context.Context, *RetryStats) {
+	stats := new(RetryStats)
+	return context.WithValue(ctx, retryStatsKey{}, stats), stats
+}
+
+// RetryTransport is an http.RoundTripper that retries requests throttled by Jira.
+// It honors the Retry-After and X-RateLimit-Reset headers and otherwise backs off
+// exponentially with full jitter, without waiting past the request's context deadline.
+type RetryTransport struct {
+	// The transport used to send requests. Defaults to http.DefaultTransport
+	Base http.RoundTripper
+
+	// The retry policy
+	Policy RetryPolicy
+}
+
+// NewRetryTransport creates a new RetryTransport wrapping base
+func NewRetryTransport(base http.RoundTripper, policy RetryPolicy) *RetryTransport {
+	return &RetryTransport{
+		Base:   base,
+		Policy: policy,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	maxRetries := t.Policy.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	if !t.canRetry(req) {
+		maxRetries = 0
+	}
+
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			var err error
+			if attemptReq, err = rewindRequest(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := base.RoundTrip(attemptReq)
+		if attempt >= maxRetries || !t.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			// Waiting would exceed the deadline, hand back the last result instead
+			return resp, err
+		}
+
+		event := RetryEvent{
+			Request: req,
+			Attempt: attempt + 1,
+			Err:     err,
+			Wait:    wait,
+		}
+		if resp != nil {
+			event.StatusCode = resp.StatusCode
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if stats, ok := ctx.Value(retryStatsKey{}).(*RetryStats); ok {
+			stats.retries.Add(1)
+		}
+		if t.Policy.OnRetry != nil {
+			t.Policy.OnRetry(event)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// canRetry reports whether the request may be sent more than once
+func (t *RetryTransport) canRetry(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if t.Policy.RetryNonIdempotent {
+		return true
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether the result of an attempt is worth retrying
+func (t *RetryTransport) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+
+	codes := t.Policy.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+	}
+	for _, code := range codes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := serverRetryDelay(resp.Header, time.Now()); ok {
+			return wait
+		}
+	}
+
+	maxDelay := t.Policy.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultRetryMaxDelay
+	}
+	baseDelay := t.Policy.BaseDelay
+	if baseDelay <= 0 {
+		baseDelay = DefaultRetryBaseDelay
+	}
+
+	// Full jitter: pick a random delay up to the exponential ceiling.
+	// The ceiling is doubled one attempt at a time so that it stops at maxDelay instead of overflowing.
+	ceiling := min(baseDelay, maxDelay)
+	for i := 0; i < attempt && ceiling < maxDelay; i++ {
+		ceiling = min(ceiling*2, maxDelay)
+	}
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+// serverRetryDelay reads the delay requested by Jira from the Retry-After or X-RateLimit-Reset headers
+func serverRetryDelay(header http.Header, now time.Time) (time.Duration, bool) {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return max(at.Sub(now), 0), true
+		}
+	}
+
+	if v := header.Get("X-RateLimit-Reset"); v != "" {
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00"} {
+			if at, err := time.Parse(layout, v); err == nil {
+				return max(at.Sub(now), 0), true
+			}
+		}
+		if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(time.Unix(epoch, 0).Sub(now), 0), true
+		}
+	}
+
+	return 0, false
+}
+
+// rewindRequest clones req with a fresh body for another attempt
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
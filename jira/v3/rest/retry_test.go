@@ -0,0 +1,285 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		policy       RetryPolicy
+		failures     int
+		failureCode  int
+		wantAttempts int32
+		wantStatus   int
+	}{
+		{
+			name:         "retries 429 until success",
+			method:       http.MethodGet,
+			failures:     2,
+			failureCode:  http.StatusTooManyRequests,
+			wantAttempts: 3,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "retries 503",
+			method:       http.MethodGet,
+			failures:     1,
+			failureCode:  http.StatusServiceUnavailable,
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "gives up after max retries",
+			method:       http.MethodGet,
+			policy:       RetryPolicy{MaxRetries: 2},
+			failures:     10,
+			failureCode:  http.StatusTooManyRequests,
+			wantAttempts: 3,
+			wantStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name:         "large base delay does not overflow",
+			method:       http.MethodGet,
+			policy:       RetryPolicy{MaxRetries: 40, BaseDelay: 5 * time.Second, MaxDelay: time.Millisecond},
+			failures:     100,
+			failureCode:  http.StatusTooManyRequests,
+			wantAttempts: 41,
+			wantStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name:         "does not retry other errors",
+			method:       http.MethodGet,
+			failures:     1,
+			failureCode:  http.StatusInternalServerError,
+			wantAttempts: 1,
+			wantStatus:   http.StatusInternalServerError,
+		},
+		{
+			name:         "does not retry POST by default",
+			method:       http.MethodPost,
+			failures:     1,
+			failureCode:  http.StatusTooManyRequests,
+			wantAttempts: 1,
+			wantStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name:         "retries POST when allowed",
+			method:       http.MethodPost,
+			policy:       RetryPolicy{RetryNonIdempotent: true},
+			failures:     1,
+			failureCode:  http.StatusTooManyRequests,
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "negative max retries disables retries",
+			method:       http.MethodGet,
+			policy:       RetryPolicy{MaxRetries: -1},
+			failures:     1,
+			failureCode:  http.StatusTooManyRequests,
+			wantAttempts: 1,
+			wantStatus:   http.StatusTooManyRequests,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				if r.Method == http.MethodPost {
+					body, _ := io.ReadAll(r.Body)
+					if string(body) != `{"jql":"project = TEST"}`+"\n" {
+						t.Errorf("Attempt %d body = %q", n, body)
+					}
+				}
+				if int(n) <= tt.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tt.failureCode)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			policy := tt.policy
+			policy.BaseDelay = time.Millisecond
+			httpClient := &http.Client{Transport: NewRetryTransport(nil, policy)}
+			client := NewClient(httpClient, server.URL, nil)
+
+			var body interface{}
+			if tt.method == http.MethodPost {
+				body = map[string]string{"jql": "project = TEST"}
+			}
+			req, err := client.NewRequest(context.Background(), tt.method, "/", body)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+
+			resp, err := httpClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if attempts.Load() != tt.wantAttempts {
+				t.Errorf("attempts = %v, want %v", attempts.Load(), tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_StatsAndHook(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var events []RetryEvent
+	policy := RetryPolicy{
+		BaseDelay: time.Millisecond,
+		OnRetry: func(e RetryEvent) {
+			events = append(events, e)
+		},
+	}
+	client := NewClient(&http.Client{Transport: NewRetryTransport(nil, policy)}, server.URL, nil)
+
+	ctx, stats := WithRetryStats(context.Background())
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	if err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	if stats.Retries() != 2 {
+		t.Errorf("Retries() = %v, want 2", stats.Retries())
+	}
+	if len(events) != 2 {
+		t.Fatalf("OnRetry called %d times, want 2", len(events))
+	}
+	if events[1].Attempt != 2 || events[1].StatusCode != http.StatusTooManyRequests {
+		t.Errorf("events[1] = %+v", events[1])
+	}
+}
+
+func TestRetryTransport_RespectsDeadline(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(&http.Client{Transport: NewRetryTransport(nil, RetryPolicy{})}, server.URL, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	req, _ := client.NewRequest(ctx, http.MethodGet, "/", nil)
+	err := client.Do(req, nil)
+	if !IsRateLimited(err) {
+		t.Errorf("Do() error = %v, want rate limited error", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("Do() waited %v, want to give up immediately", time.Since(start))
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("attempts = %v, want 1", attempts.Load())
+	}
+}
+
+func TestServerRetryDelay(t *testing.T) {
+	now := time.Date(2024, 5, 14, 14, 40, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header map[string]string
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			name:   "retry after seconds",
+			header: map[string]string{"Retry-After": "7"},
+			want:   7 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "retry after date",
+			header: map[string]string{"Retry-After": now.Add(3 * time.Second).Format(http.TimeFormat)},
+			want:   3 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "rate limit reset",
+			header: map[string]string{"X-RateLimit-Reset": "2024-05-14T14:40:05Z"},
+			want:   5 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "rate limit reset in the past",
+			header: map[string]string{"X-RateLimit-Reset": "2024-05-14T14:39Z"},
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "no header",
+			header: map[string]string{},
+		},
+		{
+			name:   "invalid header",
+			header: map[string]string{"Retry-After": "soon"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+			got, ok := serverRetryDelay(header, now)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("serverRetryDelay() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_BackoffCeiling(t *testing.T) {
+	transport := NewRetryTransport(nil, RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond})
+	resp := &http.Response{Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}
+
+	for attempt := 0; attempt < 40; attempt++ {
+		wait := transport.backoff(attempt, resp)
+		if wait < 0 || wait > 50*time.Millisecond {
+			t.Errorf("backoff(%d) = %v, want within [0, 50ms]", attempt, wait)
+		}
+	}
+
+	// A base delay whose doubling would overflow stays within the maximum
+	transport = NewRetryTransport(nil, RetryPolicy{BaseDelay: 5 * time.Second, MaxDelay: time.Hour})
+	for attempt := 0; attempt < 100; attempt++ {
+		wait := transport.backoff(attempt, resp)
+		if wait < 0 || wait > time.Hour {
+			t.Errorf("backoff(%d) = %v, want within [0, 1h]", attempt, wait)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package utils
+
+// Bool returns a pointer to v, for optional boolean options
+func Bool(v bool) *bool {
+	return &v
+}
+
+// String returns a pointer to v, for optional string fields where "" is a value of its own
+func String(v string) *string {
+	return &v
+}
@@ -2,14 +2,16 @@ package jirareport
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/ducminhgd/go-atlassian/internal/msteams"
+	jira "github.com/ducminhgd/go-atlassian/jira/v3"
+	"github.com/ducminhgd/go-atlassian/jira/v3/adf"
 	"github.com/ducminhgd/go-atlassian/jira/v3/auth"
 	"github.com/ducminhgd/go-atlassian/jira/v3/issue"
 	"github.com/ducminhgd/go-atlassian/jira/v3/utils"
@@ -17,9 +19,9 @@ import (
 
 // Generator handles the report generation
 type Generator struct {
-	config        *Config
-	issueService  *issue.Service
-	authenticator auth.Authenticator
+	config       *Config
+	client       *jira.Client
+	issueService *issue.Service
 }
 
 // NewGenerator creates a new report generator
@@ -33,17 +35,23 @@ func NewGenerator(config *Config) (*Generator, error) {
 	if config.JiraUsername != "" {
 		authenticator = auth.NewBasicAuth(config.JiraUsername, config.JiraPassword)
 	} else {
-		// If no username provided, use empty username with token as password
-		authenticator = auth.NewBasicAuth("", config.JiraPassword)
+		// If no username provided, use the password as a personal access token
+		authenticator = auth.NewTokenAuth(config.JiraPassword)
 	}
 
-	client := &http.Client{}
-	issueService := issue.NewService(client, config.JiraHost, authenticator)
+	// Parents and epics are fetched one by one, so retry when Jira throttles the report
+	client, err := jira.NewClient(config.JiraHost,
+		jira.WithAuthenticator(authenticator),
+		jira.WithRetry(jira.RetryPolicy{}),
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Generator{
-		config:        config,
-		issueService:  issueService,
-		authenticator: authenticator,
+		config:       config,
+		client:       client,
+		issueService: client.Issue,
 	}, nil
 }
 
@@ -56,13 +64,13 @@ func (g *Generator) Generate(ctx context.Context) (*Report, error) {
 	// Build search request based on query type
 	searchRequest, err := g.buildSearchRequest(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrSearchIssues, err)
+		return nil, fmt.Errorf("%w: %w", ErrSearchIssues, err)
 	}
 
-	// Search for issues
-	response, err := g.issueService.SearchJQL(ctx, *searchRequest)
+	// Search for issues, following every page of results
+	issues, err := g.issueService.SearchJQLAll(ctx, *searchRequest, 0)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrSearchIssues, err)
+		return nil, fmt.Errorf("%w: %w", ErrSearchIssues, err)
 	}
 
 	// Process issues and build hierarchical tree structure
@@ -70,7 +78,7 @@ func (g *Generator) Generate(ctx context.Context) (*Report, error) {
 	var noEpicIssues []IssueUpdate
 	processedIssues := make(map[string]*IssueUpdate) // Track all processed issues
 
-	for _, iss := range response.Issues {
+	for _, iss := range issues {
 		// Skip if this issue has already been processed and added to report
 		if existingIssue, exists := processedIssues[iss.Key]; exists && existingIssue.AddedToReport {
 			continue
@@ -320,39 +328,20 @@ func (g *Generator) buildProjectAndHoursJQL() string {
 
 // getFilterJQL retrieves JQL from a saved filter
 func (g *Generator) getFilterJQL(ctx context.Context) (string, error) {
-	// Build the URL for getting filter details
-	url := fmt.Sprintf("%s/rest/api/3/filter/%s", g.config.JiraHost, g.config.FilterID)
+	path := fmt.Sprintf("/rest/api/3/filter/%s", url.PathEscape(g.config.FilterID))
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := g.client.Rest().NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Add authentication
-	if err := g.authenticator.AddAuthentication(req); err != nil {
-		return "", fmt.Errorf("failed to add authentication: %w", err)
-	}
-
-	req.Header.Set("Accept", "application/json")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("failed to get filter: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("failed to get filter: status %d", resp.StatusCode)
-	}
-
 	var filterResponse struct {
 		JQL  string `json:"jql"`
 		Name string `json:"name"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&filterResponse); err != nil {
-		return "", fmt.Errorf("failed to decode filter response: %w", err)
+	if err := g.client.Rest().Do(req, &filterResponse); err != nil {
+		return "", fmt.Errorf("failed to get filter: %w", err)
 	}
 
 	// Store filter name in config for subtitle generation
@@ -383,7 +372,7 @@ func (g *Generator) WithFilter(filterID string) *Generator {
 	return g
 }
 
-// extractTextFromBody extracts plain text from ADF or string body
+// extractTextFromBody extracts plain text from ADF or string body, on a single line
 func extractTextFromBody(body interface{}) string {
 	if body == nil {
 		return ""
@@ -395,40 +384,12 @@ func extractTextFromBody(body interface{}) string {
 	}
 
 	// Try to handle as ADF (Atlassian Document Format)
-	bodyBytes, err := json.Marshal(body)
+	doc, err := adf.Parse(body)
 	if err != nil {
 		return fmt.Sprintf("%v", body)
 	}
 
-	var adf map[string]interface{}
-	if err := json.Unmarshal(bodyBytes, &adf); err != nil {
-		return string(bodyBytes)
-	}
-
-	// Extract text from ADF content
-	return extractTextFromADF(adf)
-}
-
-// extractTextFromADF recursively extracts text from ADF structure
-func extractTextFromADF(node map[string]interface{}) string {
-	var text strings.Builder
-
-	if nodeType, ok := node["type"].(string); ok && nodeType == "text" {
-		if textContent, ok := node["text"].(string); ok {
-			return textContent
-		}
-	}
-
-	if content, ok := node["content"].([]interface{}); ok {
-		for _, item := range content {
-			if itemMap, ok := item.(map[string]interface{}); ok {
-				text.WriteString(extractTextFromADF(itemMap))
-				text.WriteString(" ")
-			}
-		}
-	}
-
-	return strings.TrimSpace(text.String())
+	return strings.Join(strings.Fields(adf.ToText(doc)), " ")
 }
 
 // truncateText truncates text to a maximum length
@@ -0,0 +1,56 @@
+package jirareport
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestExtractTextFromBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "plain text",
+			body: `"Deployed to staging"`,
+			want: "Deployed to staging",
+		},
+		{
+			name: "paragraphs",
+			body: `{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[{"type":"text","text":"Deployed"}]},
+				{"type":"paragraph","content":[{"type":"text","text":"to staging"}]}]}`,
+			want: "Deployed to staging",
+		},
+		{
+			name: "resized image",
+			body: `{"type":"doc","version":1,"content":[
+				{"type":"paragraph","content":[{"type":"text","text":"Screenshot:"}]},
+				{"type":"mediaSingle","attrs":{"layout":"center","width":66.67},"content":[
+					{"type":"media","attrs":{"type":"file","id":"abc-123","collection":"","alt":"error.png"}}]}]}`,
+			want: "Screenshot: [media: error.png]",
+		},
+		{
+			name: "resized table column",
+			body: `{"type":"doc","version":1,"content":[{"type":"table","content":[{"type":"tableRow","content":[
+				{"type":"tableHeader","attrs":{"colwidth":[150.5]},"content":[{"type":"paragraph","content":[{"type":"text","text":"Env"}]}]},
+				{"type":"tableCell","attrs":{"colwidth":[220.25]},"content":[{"type":"paragraph","content":[{"type":"text","text":"staging"}]}]}]}]}]}`,
+			want: "Env | staging",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Bodies reach the report decoded from the issue JSON
+			var body interface{}
+			if err := json.Unmarshal([]byte(tt.body), &body); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if got := extractTextFromBody(body); got != tt.want {
+				t.Errorf("extractTextFromBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}